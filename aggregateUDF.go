@@ -0,0 +1,300 @@
+package duckdb
+
+/*
+#include <duckdb.h>
+
+idx_t aggregate_udf_state_size(duckdb_function_info);
+void aggregate_udf_init(duckdb_function_info, duckdb_aggregate_state);
+void aggregate_udf_update(duckdb_function_info, duckdb_data_chunk, duckdb_aggregate_state *);
+void aggregate_udf_combine(duckdb_function_info, duckdb_aggregate_state *, duckdb_aggregate_state *, idx_t);
+void aggregate_udf_finalize(duckdb_function_info, duckdb_aggregate_state *, duckdb_vector, idx_t, idx_t);
+void aggregate_udf_destroy(duckdb_aggregate_state *, idx_t);
+void udf_delete_callback(void *);
+
+typedef idx_t (*aggregate_udf_state_size_t)(duckdb_function_info);
+typedef void (*aggregate_udf_init_t)(duckdb_function_info, duckdb_aggregate_state);
+typedef void (*aggregate_udf_update_t)(duckdb_function_info, duckdb_data_chunk, duckdb_aggregate_state *);
+typedef void (*aggregate_udf_combine_t)(duckdb_function_info, duckdb_aggregate_state *, duckdb_aggregate_state *, idx_t);
+typedef void (*aggregate_udf_finalize_t)(duckdb_function_info, duckdb_aggregate_state *, duckdb_vector, idx_t, idx_t);
+typedef void (*aggregate_udf_destroy_t)(duckdb_aggregate_state *, idx_t);
+*/
+import "C"
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"runtime"
+	"runtime/cgo"
+	"unsafe"
+)
+
+// AggregateFuncConfig contains the fields to configure a user-defined aggregate function.
+type AggregateFuncConfig struct {
+	// InputTypeInfos contains Type information for each input parameter of the aggregate function.
+	InputTypeInfos []TypeInfo
+	// ResultTypeInfo holds the Type information of the aggregate function's result type.
+	ResultTypeInfo TypeInfo
+
+	// SpecialNullHandling disables the default NULL handling of aggregate functions, if true.
+	// The default NULL handling skips all rows containing a NULL input value, i.e., Update is only invoked
+	// for rows without NULL input values.
+	SpecialNullHandling bool
+}
+
+// AggregateFuncExecutor contains the callback functions to execute a user-defined aggregate function.
+// The state is any Go value, e.g., a running sum, which the package keeps per group of the aggregation.
+// All callback functions must be set.
+type AggregateFuncExecutor struct {
+	// Init returns the initial state of an aggregation.
+	Init func() any
+	// Update adds a row to the state, and returns the updated state, or error.
+	// []driver.Value contains the row values.
+	Update func(state any, values []driver.Value) (any, error)
+	// Combine merges the source state into the target state, and returns the merged state, or error.
+	// DuckDB combines states when aggregating in parallel.
+	Combine func(target any, source any) (any, error)
+	// Finalize returns the aggregation result of a state, or error. A nil result is NULL.
+	// Groups without any (non-NULL) rows finalize the initial state.
+	Finalize func(state any) (any, error)
+}
+
+// AggregateFunc is the user-defined aggregate function interface.
+// Any aggregate function must implement a Config function, and an Executor function.
+type AggregateFunc interface {
+	// Config returns AggregateFuncConfig to configure the aggregate function.
+	Config() AggregateFuncConfig
+	// Executor returns AggregateFuncExecutor to execute the aggregate function.
+	Executor() AggregateFuncExecutor
+}
+
+// aggregateState boxes the state of an aggregation, so that the callbacks can replace it.
+type aggregateState struct {
+	value any
+}
+
+// RegisterAggregateUDF registers a user-defined aggregate function.
+// *sql.Conn is the SQL connection on which to register the aggregate function.
+// name is the function name, and f is the aggregate function's interface AggregateFunc.
+// RegisterAggregateUDF takes ownership of f, so you must pass it as a pointer.
+func RegisterAggregateUDF(c *sql.Conn, name string, f AggregateFunc) error {
+	function, err := createAggregateFunc(name, f)
+	if err != nil {
+		return getError(errAPI, err)
+	}
+
+	// Register the function on the underlying driver connection exposed by c.Raw.
+	err = c.Raw(func(driverConn any) error {
+		con := driverConn.(*Conn)
+		state := C.duckdb_register_aggregate_function(con.duckdbCon, function)
+		C.duckdb_destroy_aggregate_function(&function)
+		if state == C.DuckDBError {
+			return getError(errAPI, errAggregateUDFCreate)
+		}
+		return nil
+	})
+	return err
+}
+
+func getAggregateState(state C.duckdb_aggregate_state) *aggregateState {
+	h := *(*cgo.Handle)(unsafe.Pointer(state))
+	return h.Value().(*aggregateState)
+}
+
+func getAggregateStates(states *C.duckdb_aggregate_state, count C.idx_t) []C.duckdb_aggregate_state {
+	return unsafe.Slice(states, int(count))
+}
+
+//export aggregate_udf_state_size
+func aggregate_udf_state_size(C.duckdb_function_info) C.idx_t {
+	var h cgo.Handle
+	return C.idx_t(unsafe.Sizeof(h))
+}
+
+//export aggregate_udf_init
+func aggregate_udf_init(function_info C.duckdb_function_info, state C.duckdb_aggregate_state) {
+	extraInfo := C.duckdb_aggregate_function_get_extra_info(function_info)
+	function := getPinned[AggregateFunc](extraInfo)
+
+	h := cgo.NewHandle(&aggregateState{value: function.Executor().Init()})
+	*(*cgo.Handle)(unsafe.Pointer(state)) = h
+}
+
+//export aggregate_udf_update
+func aggregate_udf_update(function_info C.duckdb_function_info, input C.duckdb_data_chunk, states *C.duckdb_aggregate_state) {
+	extraInfo := C.duckdb_aggregate_function_get_extra_info(function_info)
+	function := getPinned[AggregateFunc](extraInfo)
+
+	// Initialize the input chunk.
+	var inputChunk DataChunk
+	if err := inputChunk.initFromDuckDataChunk(input, false); err != nil {
+		setAggregateFuncError(function_info, getError(errAPI, err).Error())
+		return
+	}
+
+	executor := function.Executor()
+	skipNullRows := !function.Config().SpecialNullHandling
+	values := make([]driver.Value, len(inputChunk.columns))
+	rowCount := inputChunk.GetSize()
+	rowStates := getAggregateStates(states, C.idx_t(rowCount))
+
+	// Update the state of each row.
+	var err error
+	for rowIdx := 0; rowIdx < rowCount; rowIdx++ {
+		nullRow := false
+
+		// Get each column value.
+		for colIdx := range values {
+			if values[colIdx], err = inputChunk.GetValue(colIdx, rowIdx); err != nil {
+				setAggregateFuncError(function_info, getError(errAPI, err).Error())
+				return
+			}
+			if skipNullRows && values[colIdx] == nil {
+				nullRow = true
+				break
+			}
+		}
+		if nullRow {
+			continue
+		}
+
+		state := getAggregateState(rowStates[rowIdx])
+		if state.value, err = executor.Update(state.value, values); err != nil {
+			setAggregateFuncError(function_info, getError(errAPI, err).Error())
+			return
+		}
+	}
+}
+
+//export aggregate_udf_combine
+func aggregate_udf_combine(function_info C.duckdb_function_info, source *C.duckdb_aggregate_state, target *C.duckdb_aggregate_state, count C.idx_t) {
+	extraInfo := C.duckdb_aggregate_function_get_extra_info(function_info)
+	function := getPinned[AggregateFunc](extraInfo)
+	executor := function.Executor()
+
+	sourceStates := getAggregateStates(source, count)
+	targetStates := getAggregateStates(target, count)
+
+	var err error
+	for i := range sourceStates {
+		src := getAggregateState(sourceStates[i])
+		dst := getAggregateState(targetStates[i])
+		if dst.value, err = executor.Combine(dst.value, src.value); err != nil {
+			setAggregateFuncError(function_info, getError(errAPI, err).Error())
+			return
+		}
+	}
+}
+
+//export aggregate_udf_finalize
+func aggregate_udf_finalize(function_info C.duckdb_function_info, source *C.duckdb_aggregate_state, result C.duckdb_vector, count C.idx_t, offset C.idx_t) {
+	extraInfo := C.duckdb_aggregate_function_get_extra_info(function_info)
+	function := getPinned[AggregateFunc](extraInfo)
+	executor := function.Executor()
+
+	// Initialize the output chunk.
+	var outputChunk DataChunk
+	if err := outputChunk.initFromDuckVector(result, true); err != nil {
+		setAggregateFuncError(function_info, getError(errAPI, err).Error())
+		return
+	}
+
+	for i, state := range getAggregateStates(source, count) {
+		val, err := executor.Finalize(getAggregateState(state).value)
+		if err != nil {
+			setAggregateFuncError(function_info, getError(errAPI, err).Error())
+			return
+		}
+
+		// Write the result to the output chunk.
+		if err = outputChunk.SetValue(0, int(offset)+i, val); err != nil {
+			setAggregateFuncError(function_info, getError(errAPI, err).Error())
+			return
+		}
+	}
+}
+
+//export aggregate_udf_destroy
+func aggregate_udf_destroy(states *C.duckdb_aggregate_state, count C.idx_t) {
+	for _, state := range getAggregateStates(states, count) {
+		h := (*cgo.Handle)(unsafe.Pointer(state))
+		h.Delete()
+	}
+}
+
+func registerAggregateParams(config AggregateFuncConfig, f C.duckdb_aggregate_function) error {
+	for i, info := range config.InputTypeInfos {
+		if info == nil {
+			return addIndexToError(errAggregateUDFInputTypeIsNil, i)
+		}
+		t := info.logicalType()
+		C.duckdb_aggregate_function_add_parameter(f, t)
+		C.duckdb_destroy_logical_type(&t)
+	}
+
+	if config.ResultTypeInfo == nil {
+		return errAggregateUDFResultTypeIsNil
+	}
+	if config.ResultTypeInfo.InternalType() == TYPE_ANY {
+		return errAggregateUDFResultTypeIsANY
+	}
+	t := config.ResultTypeInfo.logicalType()
+	C.duckdb_aggregate_function_set_return_type(f, t)
+	C.duckdb_destroy_logical_type(&t)
+	return nil
+}
+
+func createAggregateFunc(name string, f AggregateFunc) (C.duckdb_aggregate_function, error) {
+	if name == "" {
+		return nil, errAggregateUDFNoName
+	}
+	if f == nil {
+		return nil, errAggregateUDFIsNil
+	}
+	executor := f.Executor()
+	if executor.Init == nil || executor.Update == nil || executor.Combine == nil || executor.Finalize == nil {
+		return nil, errAggregateUDFNoExecutor
+	}
+
+	function := C.duckdb_create_aggregate_function()
+
+	// Set the name.
+	cName := C.CString(name)
+	defer C.duckdb_free(unsafe.Pointer(cName))
+	C.duckdb_aggregate_function_set_name(function, cName)
+
+	// Configure the aggregate function.
+	config := f.Config()
+	if err := registerAggregateParams(config, function); err != nil {
+		C.duckdb_destroy_aggregate_function(&function)
+		return nil, err
+	}
+	if config.SpecialNullHandling {
+		C.duckdb_aggregate_function_set_special_handling(function)
+	}
+
+	// Set the function callbacks.
+	C.duckdb_aggregate_function_set_functions(
+		function,
+		C.aggregate_udf_state_size_t(C.aggregate_udf_state_size),
+		C.aggregate_udf_init_t(C.aggregate_udf_init),
+		C.aggregate_udf_update_t(C.aggregate_udf_update),
+		C.aggregate_udf_combine_t(C.aggregate_udf_combine),
+		C.aggregate_udf_finalize_t(C.aggregate_udf_finalize))
+	C.duckdb_aggregate_function_set_destructor(function, C.aggregate_udf_destroy_t(C.aggregate_udf_destroy))
+
+	// Pin the AggregateFunc f.
+	value := pinnedValue[AggregateFunc]{
+		pinner: &runtime.Pinner{},
+		value:  f,
+	}
+	h := cgo.NewHandle(value)
+	value.pinner.Pin(&h)
+
+	// Set the execution data, which is the AggregateFunc f.
+	C.duckdb_aggregate_function_set_extra_info(
+		function,
+		unsafe.Pointer(&h),
+		C.duckdb_delete_callback_t(C.udf_delete_callback))
+
+	return function, nil
+}
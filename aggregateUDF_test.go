@@ -0,0 +1,190 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testAUDF struct {
+	config   AggregateFuncConfig
+	executor AggregateFuncExecutor
+}
+
+func (udf *testAUDF) Config() AggregateFuncConfig {
+	return udf.config
+}
+
+func (udf *testAUDF) Executor() AggregateFuncExecutor {
+	return udf.executor
+}
+
+// sumState is the state of the my_sum aggregate function.
+// It tracks whether it aggregated any values, so that empty groups result in NULL.
+type sumState struct {
+	sum   int64
+	valid bool
+}
+
+var sumExecutor = AggregateFuncExecutor{
+	Init: func() any {
+		return sumState{}
+	},
+	Update: func(state any, values []driver.Value) (any, error) {
+		s := state.(sumState)
+		return sumState{sum: s.sum + values[0].(int64), valid: true}, nil
+	},
+	Combine: func(target any, source any) (any, error) {
+		t, s := target.(sumState), source.(sumState)
+		return sumState{sum: t.sum + s.sum, valid: t.valid || s.valid}, nil
+	},
+	Finalize: func(state any) (any, error) {
+		s := state.(sumState)
+		if !s.valid {
+			return nil, nil
+		}
+		return s.sum, nil
+	},
+}
+
+var countNullsExecutor = AggregateFuncExecutor{
+	Init: func() any {
+		return int64(0)
+	},
+	Update: func(state any, values []driver.Value) (any, error) {
+		if values[0] == nil {
+			return state.(int64) + 1, nil
+		}
+		return state, nil
+	},
+	Combine: func(target any, source any) (any, error) {
+		return target.(int64) + source.(int64), nil
+	},
+	Finalize: func(state any) (any, error) {
+		return state, nil
+	},
+}
+
+func TestAggregateUDF(t *testing.T) {
+	db, err := sql.Open("duckdb", "")
+	require.NoError(t, err)
+
+	c, err := db.Conn(context.Background())
+	require.NoError(t, err)
+
+	bigintInfo, err := NewTypeInfo(TYPE_BIGINT)
+	require.NoError(t, err)
+	config := AggregateFuncConfig{InputTypeInfos: []TypeInfo{bigintInfo}, ResultTypeInfo: bigintInfo}
+
+	require.NoError(t, RegisterAggregateUDF(c, "my_sum", &testAUDF{config: config, executor: sumExecutor}))
+
+	// Aggregate enough rows to run in parallel, and to combine states.
+	var sum int64
+	row := c.QueryRowContext(context.Background(), `SELECT my_sum(range) FROM range(1000000)`)
+	require.NoError(t, row.Scan(&sum))
+	require.Equal(t, int64(999999*1000000/2), sum)
+
+	// Grouped aggregation.
+	rows, err := c.QueryContext(context.Background(), `
+		SELECT range % 3 AS g, my_sum(range), SUM(range)::BIGINT
+		FROM range(100000)
+		GROUP BY g
+		ORDER BY g`)
+	require.NoError(t, err)
+	groups := 0
+	for rows.Next() {
+		var g, mySum, expected int64
+		require.NoError(t, rows.Scan(&g, &mySum, &expected))
+		require.Equal(t, int64(groups), g)
+		require.Equal(t, expected, mySum)
+		groups++
+	}
+	require.NoError(t, rows.Err())
+	require.NoError(t, rows.Close())
+	require.Equal(t, 3, groups)
+
+	// The default NULL handling skips NULL rows.
+	var res *int64
+	row = c.QueryRowContext(context.Background(), `SELECT my_sum(v) FROM (VALUES (1), (NULL), (41)) t(v)`)
+	require.NoError(t, row.Scan(&res))
+	require.Equal(t, int64(42), *res)
+
+	// Groups without any non-NULL rows finalize the initial state.
+	row = c.QueryRowContext(context.Background(), `SELECT my_sum(v) FROM (VALUES (NULL::BIGINT)) t(v)`)
+	require.NoError(t, row.Scan(&res))
+	require.Nil(t, res)
+
+	// Special NULL handling passes NULL rows to Update.
+	config.SpecialNullHandling = true
+	require.NoError(t, RegisterAggregateUDF(c, "count_nulls", &testAUDF{config: config, executor: countNullsExecutor}))
+
+	var nulls int64
+	row = c.QueryRowContext(context.Background(), `
+		SELECT count_nulls(CASE WHEN range % 4 = 0 THEN NULL ELSE range END) FROM range(10000)`)
+	require.NoError(t, row.Scan(&nulls))
+	require.Equal(t, int64(2500), nulls)
+
+	require.NoError(t, c.Close())
+	require.NoError(t, db.Close())
+}
+
+func TestErrAggregateUDF(t *testing.T) {
+	db, err := sql.Open("duckdb", "")
+	require.NoError(t, err)
+
+	c, err := db.Conn(context.Background())
+	require.NoError(t, err)
+
+	bigintInfo, err := NewTypeInfo(TYPE_BIGINT)
+	require.NoError(t, err)
+	anyInfo, err := NewTypeInfo(TYPE_ANY)
+	require.NoError(t, err)
+	config := AggregateFuncConfig{InputTypeInfos: []TypeInfo{bigintInfo}, ResultTypeInfo: bigintInfo}
+
+	err = RegisterAggregateUDF(c, "", &testAUDF{config: config, executor: sumExecutor})
+	testError(t, err, errAPI.Error(), errAggregateUDFNoName.Error())
+
+	err = RegisterAggregateUDF(c, "my_sum", nil)
+	testError(t, err, errAPI.Error(), errAggregateUDFIsNil.Error())
+
+	incomplete := sumExecutor
+	incomplete.Combine = nil
+	err = RegisterAggregateUDF(c, "my_sum", &testAUDF{config: config, executor: incomplete})
+	testError(t, err, errAPI.Error(), errAggregateUDFNoExecutor.Error())
+
+	err = RegisterAggregateUDF(c, "my_sum", &testAUDF{
+		config:   AggregateFuncConfig{InputTypeInfos: []TypeInfo{nil}, ResultTypeInfo: bigintInfo},
+		executor: sumExecutor,
+	})
+	testError(t, err, errAPI.Error(), errAggregateUDFInputTypeIsNil.Error())
+
+	err = RegisterAggregateUDF(c, "my_sum", &testAUDF{
+		config:   AggregateFuncConfig{InputTypeInfos: []TypeInfo{bigintInfo}},
+		executor: sumExecutor,
+	})
+	testError(t, err, errAPI.Error(), errAggregateUDFResultTypeIsNil.Error())
+
+	err = RegisterAggregateUDF(c, "my_sum", &testAUDF{
+		config:   AggregateFuncConfig{InputTypeInfos: []TypeInfo{bigintInfo}, ResultTypeInfo: anyInfo},
+		executor: sumExecutor,
+	})
+	testError(t, err, errAPI.Error(), errAggregateUDFResultTypeIsANY.Error())
+
+	// Errors during the execution.
+	failing := sumExecutor
+	failing.Update = func(any, []driver.Value) (any, error) {
+		return nil, errors.New("test invalid update")
+	}
+	require.NoError(t, RegisterAggregateUDF(c, "my_failing_sum", &testAUDF{config: config, executor: failing}))
+
+	var res *int64
+	row := c.QueryRowContext(context.Background(), `SELECT my_failing_sum(range) FROM range(10)`)
+	testError(t, row.Scan(&res), "test invalid update")
+
+	require.NoError(t, c.Close())
+	require.NoError(t, db.Close())
+}
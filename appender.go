@@ -6,8 +6,12 @@ package duckdb
 import "C"
 
 import (
+	"context"
 	"database/sql/driver"
 	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
 	"unsafe"
 )
 
@@ -21,15 +25,26 @@ type Appender struct {
 
 	// The appender storage before flushing any data.
 	chunks []DataChunk
+	// The number of rows after which the appender passes a data chunk to DuckDB.
+	chunkSize int
 	// The column types of the table to append to.
 	types []C.duckdb_logical_type
 	// A pointer to the allocated memory of the column types.
 	ptr unsafe.Pointer
 	// The number of appended rows.
 	rowCount int
+	// The total number of rows appended since creating the appender.
+	totalRowCount int
+	// The column names of the table, which are only loaded to report errors.
+	columnNames []string
+	// txEnded is true, if the appender was created in a transaction, which has ended since.
+	txEnded bool
 }
 
 // NewAppenderFromConn returns a new Appender from a DuckDB driver connection.
+// If the connection has an active transaction, then the appended rows are part of it.
+// Committing the transaction flushes the appender, and rolling it back discards its rows.
+// Afterward, the appender does not accept rows, and Close only releases it.
 func NewAppenderFromConn(driverConn driver.Conn, schema, table string) (*Appender, error) {
 	con, ok := driverConn.(*Conn)
 	if !ok {
@@ -64,6 +79,10 @@ func NewAppenderFromConn(driverConn driver.Conn, schema, table string) (*Appende
 		table:          table,
 		duckdbAppender: duckdbAppender,
 		rowCount:       0,
+		chunkSize:      GetDataChunkCapacity(),
+	}
+	if con.tx {
+		con.txAppenders = append(con.txAppenders, a)
 	}
 
 	// Get the column types.
@@ -86,10 +105,31 @@ func NewAppenderFromConn(driverConn driver.Conn, schema, table string) (*Appende
 	return a, nil
 }
 
+// SetChunkSize sets the number of rows the appender buffers before passing them to DuckDB.
+// It defaults to and must not exceed GetDataChunkCapacity. Smaller chunk sizes bound the memory
+// of the buffered rows, e.g., for tables with large rows. SetChunkSize passes any buffered rows
+// to DuckDB before changing the chunk size.
+func (a *Appender) SetChunkSize(n int) error {
+	if a.closed {
+		return getError(errAppenderAppendAfterClose, nil)
+	}
+	if n < 1 || n > GetDataChunkCapacity() {
+		return getError(errAppenderChunkSize, invalidInputError(strconv.Itoa(n), "1 to "+strconv.Itoa(GetDataChunkCapacity())))
+	}
+	if err := a.appendDataChunks(); err != nil {
+		return getError(errAppenderFlush, invalidatedAppenderError(err))
+	}
+	a.chunkSize = n
+	return nil
+}
+
 // Flush the data chunks to the underlying table and clear the internal cache.
-// Does not close the appender, even if it returns an error. Unless you have a good reason to call this,
-// call Close when you are done with the appender.
+// Does not close the appender, even if it returns an error. A failed flush invalidates the pending rows.
+// Unless you have a good reason to call this, call Close when you are done with the appender.
 func (a *Appender) Flush() error {
+	if a.txEnded {
+		return getError(errAppenderFlush, errAppenderTxEnded)
+	}
 	if err := a.appendDataChunks(); err != nil {
 		return getError(errAppenderFlush, invalidatedAppenderError(err))
 	}
@@ -110,20 +150,21 @@ func (a *Appender) Close() error {
 	}
 	a.closed = true
 
-	// Append all remaining chunks.
-	errAppend := a.appendDataChunks()
-
-	// We flush before closing to get a meaningful error message.
-	var errFlush error
-	state := C.duckdb_appender_flush(a.duckdbAppender)
-	if state == C.DuckDBError {
-		errFlush = duckdbError(C.duckdb_appender_error(a.duckdbAppender))
+	// Append all remaining chunks, and flush before closing to get a meaningful error message.
+	// The rows of an ended transaction are already flushed or discarded.
+	var errAppend, errFlush error
+	if !a.txEnded {
+		errAppend = a.appendDataChunks()
+		state := C.duckdb_appender_flush(a.duckdbAppender)
+		if state == C.DuckDBError {
+			errFlush = duckdbError(C.duckdb_appender_error(a.duckdbAppender))
+		}
 	}
 
 	// Destroy all appender data and the appender.
 	destroyTypeSlice(a.ptr, a.types)
 	var errClose error
-	state = C.duckdb_appender_destroy(&a.duckdbAppender)
+	state := C.duckdb_appender_destroy(&a.duckdbAppender)
 	if state == C.DuckDBError {
 		errClose = errAppenderClose
 	}
@@ -136,6 +177,8 @@ func (a *Appender) Close() error {
 }
 
 // AppendRow loads a row of values into the appender. The values are provided as separate arguments.
+// A nil slice appends a NULL LIST or ARRAY, and an empty slice appends an empty LIST.
+// Note that earlier versions appended an empty LIST for a nil slice.
 func (a *Appender) AppendRow(args ...driver.Value) error {
 	if a.closed {
 		return getError(errAppenderAppendAfterClose, nil)
@@ -148,6 +191,71 @@ func (a *Appender) AppendRow(args ...driver.Value) error {
 	return nil
 }
 
+// AppendRows appends each element of a slice of Go structs, or of pointers to Go structs, as a row.
+// Each exported struct field appends to the column with the same name, and a `db:"name"` tag overrides
+// a field's name. The fields must match the table's columns, and AppendRows maps them to the columns
+// once for all rows. If a row fails to append, then AppendRows returns an error, and keeps the previous rows.
+func (a *Appender) AppendRows(rows any) error {
+	if a.closed {
+		return getError(errAppenderAppendAfterClose, nil)
+	}
+
+	v := reflect.ValueOf(rows)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return getError(errAppenderAppendRow, castError(fmt.Sprintf("%T", rows), "a slice of structs"))
+	}
+	t := v.Type().Elem()
+	isPointer := t.Kind() == reflect.Pointer
+	if isPointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return getError(errAppenderAppendRow, castError(v.Type().String(), "a slice of structs"))
+	}
+
+	fields, err := a.columnFields(t)
+	if err != nil {
+		return getError(errAppenderAppendRow, err)
+	}
+
+	args := make([]driver.Value, len(fields))
+	for i := 0; i < v.Len(); i++ {
+		row := v.Index(i)
+		if isPointer {
+			if row.IsNil() {
+				return getError(errAppenderAppendRow, addIndexToError(interfaceIsNilError(t.String()), i))
+			}
+			row = row.Elem()
+		}
+		for j, field := range fields {
+			args[j] = row.Field(field).Interface()
+		}
+		if err = a.appendRowSlice(args); err != nil {
+			return getError(errAppenderAppendRow, err)
+		}
+	}
+	return nil
+}
+
+// columnFields returns the index of the Go struct field of each column.
+func (a *Appender) columnFields(t reflect.Type) ([]int, error) {
+	fields := structFields(t)
+	indexes := make([]int, len(a.types))
+	for i := range a.types {
+		name := a.columnName(i)
+		idx, ok := fields[name]
+		if !ok {
+			return nil, structFieldError("missing field", name)
+		}
+		indexes[i] = idx
+		delete(fields, name)
+	}
+	for name := range fields {
+		return nil, structFieldError("unknown field "+name, "a column of "+a.table)
+	}
+	return indexes, nil
+}
+
 func (a *Appender) addDataChunk() error {
 	var chunk DataChunk
 	if err := chunk.initFromTypes(a.ptr, a.types, true); err != nil {
@@ -158,13 +266,23 @@ func (a *Appender) addDataChunk() error {
 }
 
 func (a *Appender) appendRowSlice(args []driver.Value) error {
+	if a.txEnded {
+		return errAppenderTxEnded
+	}
 	// Early-out, if the number of args does not match the column count.
 	if len(args) != len(a.types) {
 		return columnCountError(len(args), len(a.types))
 	}
 
-	// Create a new data chunk if the current chunk is full.
-	if a.rowCount == GetDataChunkCapacity() || len(a.chunks) == 0 {
+	// Pass the current chunk to DuckDB, if it is full.
+	if a.rowCount == a.chunkSize {
+		if err := a.appendDataChunks(); err != nil {
+			return invalidatedAppenderError(err)
+		}
+	}
+
+	// Create a new data chunk.
+	if len(a.chunks) == 0 {
 		if err := a.addDataChunk(); err != nil {
 			return err
 		}
@@ -173,24 +291,69 @@ func (a *Appender) appendRowSlice(args []driver.Value) error {
 
 	// Set all values.
 	for i, val := range args {
-		chunk := &a.chunks[len(a.chunks)-1]
-		err := chunk.SetValue(i, a.rowCount, val)
+		v, err := valuerValue(val)
+		if err == nil {
+			chunk := &a.chunks[len(a.chunks)-1]
+			err = chunk.SetValue(i, a.rowCount, v)
+		}
 		if err != nil {
-			return err
+			return appendValueError(err, a.totalRowCount, a.columnName(i), logicalTypeName(a.types[i]), val)
 		}
 	}
 
 	a.rowCount++
+	a.totalRowCount++
 	return nil
 }
 
+// columnName returns the name of the column at index i, or its index, if the name is unknown.
+func (a *Appender) columnName(i int) string {
+	if a.columnNames == nil {
+		a.columnNames = a.loadColumnNames()
+	}
+	if i < len(a.columnNames) {
+		return a.columnNames[i]
+	}
+	return strconv.Itoa(i)
+}
+
+func (a *Appender) loadColumnNames() []string {
+	// Like DuckDB, prefer a temporary table over a table of the current database.
+	query := `SELECT database_name, column_name FROM duckdb_columns()
+		WHERE database_name IN ('temp', current_database()) AND schema_name = COALESCE(?, current_schema()) AND table_name = ?
+		ORDER BY database_name <> 'temp', column_index`
+
+	var schema any
+	if a.schema != "" {
+		schema = a.schema
+	}
+	args := []driver.NamedValue{{Ordinal: 1, Value: schema}, {Ordinal: 2, Value: a.table}}
+	rows, err := a.con.QueryContext(context.Background(), query, args)
+	if err != nil {
+		return []string{}
+	}
+	defer rows.Close()
+
+	names := []string{}
+	values := make([]driver.Value, 2)
+	var database any
+	for rows.Next(values) == nil {
+		if database != nil && values[0] != database {
+			break
+		}
+		database = values[0]
+		names = append(names, values[1].(string))
+	}
+	return names
+}
+
 func (a *Appender) appendDataChunks() error {
 	var state C.duckdb_state
 	var err error
 
 	for i, chunk := range a.chunks {
-		// All data chunks except the last are at maximum capacity.
-		size := GetDataChunkCapacity()
+		// All data chunks except the last are full.
+		size := a.chunkSize
 		if i == len(a.chunks)-1 {
 			size = a.rowCount
 		}
@@ -214,6 +377,27 @@ func (a *Appender) appendDataChunks() error {
 	return err
 }
 
+// endTx ends the appender's transaction. On commit, it flushes the appender into the transaction.
+// On rollback, it discards the rows not yet passed to DuckDB, and flushes DuckDB's buffered rows
+// into the transaction, so that the rollback discards them, too.
+func (a *Appender) endTx(commit bool) error {
+	defer func() { a.txEnded = true }()
+	if a.closed {
+		return nil
+	}
+	if commit {
+		return a.Flush()
+	}
+
+	for _, chunk := range a.chunks {
+		chunk.close()
+	}
+	a.chunks = a.chunks[:0]
+	a.rowCount = 0
+	C.duckdb_appender_flush(a.duckdbAppender)
+	return nil
+}
+
 func mallocTypeSlice(count int) (unsafe.Pointer, []C.duckdb_logical_type) {
 	var dummy C.duckdb_logical_type
 	size := C.size_t(unsafe.Sizeof(dummy))
@@ -230,3 +414,33 @@ func destroyTypeSlice(ptr unsafe.Pointer, slice []C.duckdb_logical_type) {
 	}
 	C.duckdb_free(ptr)
 }
+
+// valuerValue returns the value of a driver.Valuer, which then appends like any other value.
+// The vectors append Interval and UUID values natively, and a NullInterval appends its Interval.
+// Pointers to these types append like their values, and nil pointers append NULL.
+func valuerValue(val any) (any, error) {
+	switch val.(type) {
+	case *Interval, *UUID, *NullInterval:
+		if isNull(val) {
+			return nil, nil
+		}
+		val = reflect.ValueOf(val).Elem().Interface()
+	}
+
+	switch v := val.(type) {
+	case Interval, UUID:
+		return val, nil
+	case NullInterval:
+		if !v.Valid {
+			return nil, nil
+		}
+		return v.Interval, nil
+	case driver.Valuer:
+		// A nil pointer to a driver.Valuer appends NULL.
+		if isNull(val) {
+			return nil, nil
+		}
+		return v.Value()
+	}
+	return val, nil
+}
@@ -0,0 +1,130 @@
+//go:build !no_duckdb_arrow
+
+package duckdb
+
+/*
+#include <stdlib.h>
+#include <duckdb.h>
+*/
+import "C"
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+	"sync/atomic"
+	"unsafe"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/cdata"
+)
+
+// appenderArrowViews counts the temporary views created by AppendArrow to obtain unique view names.
+var appenderArrowViews atomic.Uint64
+
+// AppendArrow appends all rows of an Apache Arrow record to the table.
+// The record's columns must match the table's columns in number, order, and type, including nested types.
+// E.g., an arrow.PrimitiveTypes.Int64 column matches a BIGINT column.
+// Dictionary-encoded columns with string values can be appended to ENUM columns.
+// AppendArrow flushes the rows appended via AppendRow before appending the record, to keep the row order.
+func (a *Appender) AppendArrow(record arrow.Record) error {
+	if a.closed {
+		return getError(errAppenderAppendAfterClose, nil)
+	}
+	if int(record.NumCols()) != len(a.types) {
+		return getError(errAppenderAppendArrow, columnCountError(int(record.NumCols()), len(a.types)))
+	}
+	if err := a.Flush(); err != nil {
+		return err
+	}
+
+	// Export the record to the Arrow C data interface.
+	schema := C.calloc(1, C.size_t(unsafe.Sizeof(cdata.CArrowSchema{})))
+	arr := C.calloc(1, C.size_t(unsafe.Sizeof(cdata.CArrowArray{})))
+	defer func() {
+		cdata.ReleaseCArrowSchema((*cdata.CArrowSchema)(schema))
+		cdata.ReleaseCArrowArray((*cdata.CArrowArray)(arr))
+		C.free(schema)
+		C.free(arr)
+	}()
+	cdata.ExportArrowRecordBatch(record, (*cdata.CArrowArray)(arr), (*cdata.CArrowSchema)(schema))
+
+	// Register the record as a temporary view.
+	view := fmt.Sprintf("__duckdb_appender_arrow_%d", appenderArrowViews.Add(1))
+	cView := C.CString(view)
+	defer C.duckdb_free(unsafe.Pointer(cView))
+
+	var stream C.duckdb_arrow_stream
+	state := C.duckdb_arrow_array_scan(a.con.duckdbCon, cView, C.duckdb_arrow_schema(schema), C.duckdb_arrow_array(arr), &stream)
+	defer C.duckdb_destroy_arrow_stream(&stream)
+	if state == C.DuckDBError {
+		return getError(errAppenderAppendArrow, nil)
+	}
+	defer a.con.ExecContext(context.Background(), "DROP VIEW IF EXISTS "+quoteIdentifier(view), nil)
+
+	if err := a.validateArrowSchema(record.Schema(), view); err != nil {
+		return getError(errAppenderAppendArrow, err)
+	}
+
+	table := quoteIdentifier(a.table)
+	if a.schema != "" {
+		table = quoteIdentifier(a.schema) + "." + table
+	}
+	_, err := a.con.ExecContext(context.Background(), "INSERT INTO "+table+" SELECT * FROM "+quoteIdentifier(view), nil)
+	if err != nil {
+		return getError(errAppenderAppendArrow, err)
+	}
+	return nil
+}
+
+// validateArrowSchema compares the column types of the view scanning the record with the table's column types.
+func (a *Appender) validateArrowSchema(schema *arrow.Schema, view string) error {
+	table := quoteIdentifier(a.table)
+	if a.schema != "" {
+		table = quoteIdentifier(a.schema) + "." + table
+	}
+
+	expected, err := a.con.describeColumnTypes("DESCRIBE SELECT * FROM " + table)
+	if err != nil {
+		return err
+	}
+	actual, err := a.con.describeColumnTypes("DESCRIBE SELECT * FROM " + quoteIdentifier(view))
+	if err != nil {
+		return err
+	}
+
+	for i := range expected {
+		if actual[i] == expected[i] {
+			continue
+		}
+		// DuckDB scans dictionary-encoded strings as VARCHAR, which casts to ENUM.
+		if schema.Field(i).Type.ID() == arrow.DICTIONARY && strings.HasPrefix(expected[i], "ENUM(") {
+			continue
+		}
+		return addIndexToError(castError(actual[i], expected[i]), i+1)
+	}
+	return nil
+}
+
+// describeColumnTypes returns the column_type column of a DESCRIBE query.
+func (c *Conn) describeColumnTypes(query string) ([]string, error) {
+	rows, err := c.QueryContext(context.Background(), query, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var types []string
+	values := make([]driver.Value, len(rows.Columns()))
+	for {
+		if err = rows.Next(values); err == io.EOF {
+			return types, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		types = append(types, values[1].(string))
+	}
+}
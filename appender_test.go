@@ -1,15 +1,22 @@
 package duckdb
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"log"
 	"math/big"
 	"math/rand"
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
+	"testing/iotest"
 	"time"
 
 	_ "time/tzdata"
@@ -186,6 +193,100 @@ func TestAppendChunks(t *testing.T) {
 	cleanupAppender(t, c, con, a)
 }
 
+func TestAppenderChunkSize(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (id BIGINT, str VARCHAR)`)
+
+	// Append rows in smaller chunks, and change the chunk size with pending rows.
+	require.NoError(t, a.SetChunkSize(100))
+	for i := 0; i < 1050; i++ {
+		require.NoError(t, a.AppendRow(int64(i), strconv.Itoa(i)))
+	}
+	require.NoError(t, a.SetChunkSize(1))
+	for i := 1050; i < 1100; i++ {
+		require.NoError(t, a.AppendRow(int64(i), strconv.Itoa(i)))
+	}
+	require.NoError(t, a.SetChunkSize(GetDataChunkCapacity()))
+	for i := 1100; i < 5000; i++ {
+		require.NoError(t, a.AppendRow(int64(i), strconv.Itoa(i)))
+	}
+	require.NoError(t, a.Close())
+
+	// All rows are appended in order.
+	res, err := sql.OpenDB(c).QueryContext(context.Background(), `SELECT id, str FROM test`)
+	require.NoError(t, err)
+	i := 0
+	for res.Next() {
+		var id int64
+		var str string
+		require.NoError(t, res.Scan(&id, &str))
+		require.Equal(t, int64(i), id)
+		require.Equal(t, strconv.Itoa(i), str)
+		i++
+	}
+	require.Equal(t, 5000, i)
+	require.NoError(t, res.Close())
+
+	require.NoError(t, con.Close())
+	require.NoError(t, c.Close())
+}
+
+func TestAppenderAppendRows(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (id BIGINT, name VARCHAR, tags VARCHAR[])`)
+
+	type row struct {
+		Tags   []string `db:"tags"`
+		ID     int64    `db:"id"`
+		Name   string   `db:"name"`
+		hidden bool
+	}
+	rows := make([]row, 3000)
+	for i := range rows {
+		rows[i] = row{ID: int64(i), Tags: []string{strconv.Itoa(i)}}
+	}
+	rows[0].Name = "a"
+	require.NoError(t, a.AppendRows(rows))
+	require.NoError(t, a.AppendRows([]*row{{ID: 3000}}))
+	require.NoError(t, a.AppendRows([]row{}))
+	require.NoError(t, a.Flush())
+
+	// The column order differs from the field order.
+	var count, sum int64
+	var first string
+	db := sql.OpenDB(c)
+	err := db.QueryRow(`SELECT count(*), sum(id), first(name ORDER BY id) FROM test WHERE tags[1] = id::VARCHAR OR id = 3000`).Scan(&count, &sum, &first)
+	require.NoError(t, err)
+	require.Equal(t, int64(3001), count)
+	require.Equal(t, int64(3000*3001/2), sum)
+	require.Equal(t, "a", first)
+
+	// The struct fields must match the columns.
+	err = a.AppendRows([]struct {
+		ID   int64 `db:"id"`
+		Name string
+		Tags []string `db:"tags"`
+	}{{}})
+	testError(t, err, errAppenderAppendRow.Error(), structFieldErrMsg, "missing field")
+	err = a.AppendRows([]struct {
+		ID    int64    `db:"id"`
+		Name  string   `db:"name"`
+		Tags  []string `db:"tags"`
+		Other int
+	}{{}})
+	testError(t, err, errAppenderAppendRow.Error(), structFieldErrMsg, "unknown field Other")
+
+	err = a.AppendRows(row{})
+	testError(t, err, errAppenderAppendRow.Error(), castErrMsg, "a slice of structs")
+	err = a.AppendRows([]int{1})
+	testError(t, err, errAppenderAppendRow.Error(), castErrMsg, "a slice of structs")
+	err = a.AppendRows([]*row{nil})
+	testError(t, err, errAppenderAppendRow.Error(), interfaceIsNilErrMsg)
+
+	require.NoError(t, db.Close())
+	cleanupAppender(t, c, con, a)
+}
+
 func TestAppenderList(t *testing.T) {
 	t.Parallel()
 	c, con, a := prepareAppender(t, `
@@ -223,6 +324,100 @@ func TestAppenderList(t *testing.T) {
 	cleanupAppender(t, c, con, a)
 }
 
+func TestAppenderListElementTypes(t *testing.T) {
+	t.Parallel()
+	var infos []testTypeInfo
+	var columns, inputs []string
+	for _, info := range getTypeInfos(t, false) {
+		switch info.InternalType() {
+		case TYPE_ENUM, TYPE_LIST, TYPE_STRUCT, TYPE_MAP, TYPE_ARRAY, TYPE_UNION:
+			continue
+		}
+		columns = append(columns, fmt.Sprintf("c%d %s[]", len(infos), info))
+		inputs = append(inputs, "["+info.input+", NULL]")
+		infos = append(infos, info)
+	}
+	c, con, a := prepareAppender(t, `CREATE TABLE test (`+strings.Join(columns, ", ")+`)`)
+	db := sql.OpenDB(c)
+
+	// Scan a list with a NULL element of each type.
+	expected := make([]any, len(infos))
+	dest := make([]any, len(infos))
+	for i := range expected {
+		dest[i] = &expected[i]
+	}
+	require.NoError(t, db.QueryRow(`SELECT `+strings.Join(inputs, ", ")).Scan(dest...))
+
+	// Append the lists as []any values, and as typed slices with nil pointer elements.
+	values := make([]driver.Value, len(infos))
+	typed := make([]driver.Value, len(infos))
+	for i, v := range expected {
+		values[i] = v
+		elem := reflect.ValueOf(v.([]any)[0])
+		if elem.Kind() != reflect.Pointer {
+			ptr := reflect.New(elem.Type())
+			ptr.Elem().Set(elem)
+			elem = ptr
+		}
+		slice := reflect.MakeSlice(reflect.SliceOf(elem.Type()), 2, 2)
+		slice.Index(0).Set(elem)
+		typed[i] = slice.Interface()
+	}
+	require.NoError(t, a.AppendRow(values...))
+	require.NoError(t, a.AppendRow(typed...))
+	require.NoError(t, a.Flush())
+
+	res, err := db.Query(`SELECT * FROM test`)
+	require.NoError(t, err)
+	rowCount := 0
+	for res.Next() {
+		got := make([]any, len(infos))
+		for i := range got {
+			dest[i] = &got[i]
+		}
+		require.NoError(t, res.Scan(dest...))
+		for i, info := range infos {
+			require.Equal(t, expected[i], got[i], info.String())
+		}
+		rowCount++
+	}
+	require.Equal(t, 2, rowCount)
+	require.NoError(t, res.Close())
+	cleanupAppender(t, c, con, a)
+}
+
+func TestAppenderDecimalList(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (id INTEGER, l DECIMAL(3, 2)[], n DECIMAL(3, 2)[][])`)
+
+	// The elements use the conversions of the DECIMAL child type.
+	s := "1.25"
+	d := Decimal{Width: 3, Scale: 2, Value: big.NewInt(125)}
+	require.NoError(t, a.AppendRow(1, []string{"1.25", "-2.5"}, [][]string{{"1.25"}, nil, {}}))
+	require.NoError(t, a.AppendRow(2, []*big.Rat{big.NewRat(5, 4), nil}, [][]*big.Rat{{big.NewRat(5, 4)}}))
+	require.NoError(t, a.AppendRow(3, []Decimal{d}, [][]*Decimal{{&d, nil}}))
+	require.NoError(t, a.AppendRow(4, []*string{&s, nil}, [][]*string{{nil, &s}}))
+	require.ErrorContains(t, a.AppendRow(5, []string{"12.5"}, nil), "DECIMAL(3,2)")
+	require.NoError(t, a.Flush())
+
+	res, err := sql.OpenDB(c).Query(`SELECT l::VARCHAR, n::VARCHAR FROM test ORDER BY id`)
+	require.NoError(t, err)
+	var got [][2]string
+	for res.Next() {
+		var l, n string
+		require.NoError(t, res.Scan(&l, &n))
+		got = append(got, [2]string{l, n})
+	}
+	require.NoError(t, res.Close())
+	require.Equal(t, [][2]string{
+		{`[1.25, -2.50]`, `[[1.25], NULL, []]`},
+		{`[1.25, NULL]`, `[[1.25]]`},
+		{`[1.25]`, `[[1.25, NULL]]`},
+		{`[1.25, NULL]`, `[[NULL, 1.25]]`},
+	}, got)
+	cleanupAppender(t, c, con, a)
+}
+
 func TestAppenderArray(t *testing.T) {
 	t.Parallel()
 	c, con, a := prepareAppender(t, `CREATE TABLE test (string_array VARCHAR[3])`)
@@ -252,6 +447,31 @@ func TestAppenderArray(t *testing.T) {
 	cleanupAppender(t, c, con, a)
 }
 
+func TestAppenderNestedArray(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (nested INT[2][3], s STRUCT(a INT[2]))`)
+
+	nested := [][]int32{{1, 2}, {3, 4}, {5, 6}}
+	s := map[string]any{"a": [2]int32{7, 8}}
+	require.NoError(t, a.AppendRow(nested, s))
+	require.NoError(t, a.Flush())
+
+	// Verify results.
+	var nestedRes Composite[[][]int32]
+	var sRes Composite[map[string][]int32]
+	row := sql.OpenDB(c).QueryRowContext(context.Background(), `SELECT nested, s FROM test`)
+	require.NoError(t, row.Scan(&nestedRes, &sRes))
+	require.Equal(t, nested, nestedRes.Get())
+	require.Equal(t, map[string][]int32{"a": {7, 8}}, sRes.Get())
+
+	// A slice with a mismatching length fails.
+	err := a.AppendRow(nested[:2], s)
+	testError(t, err, errAppenderAppendRow.Error(), invalidInputErrMsg, "expected 3 ARRAY elements, got 2 elements")
+	err = a.AppendRow(nested, map[string]any{"a": []int32{1, 2, 3}})
+	testError(t, err, errAppenderAppendRow.Error(), invalidInputErrMsg, "expected 2 ARRAY elements, got 3 elements")
+	cleanupAppender(t, c, con, a)
+}
+
 func TestAppenderNested(t *testing.T) {
 	t.Parallel()
 	c, con, a := prepareAppender(t, createNestedDataTableSQL)
@@ -308,6 +528,129 @@ func TestAppenderNested(t *testing.T) {
 	cleanupAppender(t, c, con, a)
 }
 
+func TestAppenderNestedRoundTrip(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `
+		CREATE TABLE test (
+			id INTEGER,
+			list STRUCT(a INTEGER, b INTEGER[])[],
+			wrapped STRUCT(entries STRUCT(a INTEGER, b INTEGER[])[], lists INTEGER[][])
+		);
+		CREATE TABLE src AS SELECT * FROM test;
+		INSERT INTO src VALUES
+			(1, [{'a': 1, 'b': [1, 2]}, {'a': 2, 'b': []}], {'entries': [{'a': 3, 'b': [3]}], 'lists': [[1], [2, 3]]}),
+			(2, [{'a': NULL, 'b': NULL}, NULL], {'entries': [], 'lists': [NULL, []]}),
+			(3, [], {'entries': NULL, 'lists': NULL}),
+			(4, NULL, NULL)`)
+
+	type entry struct {
+		A *int32  `db:"a"`
+		B []int32 `db:"b"`
+	}
+	type wrapped struct {
+		Entries []*entry  `db:"entries"`
+		Lists   [][]int32 `db:"lists"`
+	}
+
+	db := sql.OpenDB(c)
+	res, err := db.QueryContext(context.Background(), `SELECT id, list, list, wrapped, wrapped FROM src ORDER BY id`)
+	require.NoError(t, err)
+
+	i := 0
+	for res.Next() {
+		var id int32
+		var list, w any
+		var typedList List[*entry]
+		var typedStruct Struct[*wrapped]
+		require.NoError(t, res.Scan(&id, &list, &typedList, &w, &typedStruct))
+
+		// The scanned values append without conversion, and so do the typed Go values.
+		require.NoError(t, a.AppendRow(id, list, w))
+		require.NoError(t, a.AppendRow(id+10, typedList.Get(), typedStruct.Get()))
+		i++
+	}
+	require.Equal(t, 4, i)
+	require.NoError(t, res.Close())
+	require.NoError(t, a.Flush())
+
+	var count int
+	err = db.QueryRow(`SELECT count(*) FROM src, test
+		WHERE (test.id = src.id OR test.id = src.id + 10)
+		AND test.list IS NOT DISTINCT FROM src.list
+		AND test.wrapped IS NOT DISTINCT FROM src.wrapped`).Scan(&count)
+	require.NoError(t, err)
+	require.Equal(t, 8, count)
+
+	var l List[*entry]
+	require.NoError(t, db.QueryRow(`SELECT list FROM test WHERE id = 11`).Scan(&l))
+	one, two := int32(1), int32(2)
+	require.Equal(t, []*entry{{A: &one, B: []int32{1, 2}}, {A: &two, B: []int32{}}}, l.Get())
+	cleanupAppender(t, c, con, a)
+}
+
+// money is a driver.Valuer holding an amount in cents.
+type money int64
+
+func (m money) Value() (driver.Value, error) {
+	if m < 0 {
+		return nil, errors.New("negative amount")
+	}
+	return fmt.Sprintf("%d.%02d", m/100, m%100), nil
+}
+
+func TestAppenderValuer(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (id INTEGER, amount DECIMAL(10, 2), i INTERVAL, u UUID)`)
+
+	id := UUID(uuid.New())
+	require.NoError(t, a.AppendRow(int32(1), money(1234), Interval{Days: 1}, id))
+	require.NoError(t, a.AppendRow(int32(2), (*money)(nil), NullInterval{}, NullUUID{}))
+	m := money(5)
+	require.NoError(t, a.AppendRow(int32(3), &m, NullInterval{Interval: Interval{Months: 2}, Valid: true}, NullUUID{UUID: id, Valid: true}))
+	require.NoError(t, a.AppendRow(int32(4), NullDecimal{Decimal: Decimal{Width: 10, Scale: 2, Value: big.NewInt(-99)}, Valid: true}, nil, nil))
+
+	// Pointers to the driver's types append like their values.
+	iv := Interval{Days: 3}
+	require.NoError(t, a.AppendRow(int32(5), nil, &iv, &id))
+	require.NoError(t, a.AppendRow(int32(6), nil, &NullInterval{Interval: iv, Valid: true}, (*UUID)(nil)))
+	require.NoError(t, a.AppendRow(int32(7), nil, (*NullInterval)(nil), nil))
+
+	err := a.AppendRow(int32(8), money(-1), nil, nil)
+	testError(t, err, errAppenderAppendRow.Error(), "negative amount", "column=amount")
+	require.NoError(t, a.Flush())
+
+	res, err := sql.OpenDB(c).QueryContext(context.Background(), `SELECT amount::VARCHAR, i, u FROM test ORDER BY id`)
+	require.NoError(t, err)
+	str := func(s string) *string { return &s }
+	expected := []struct {
+		amount *string
+		i      NullInterval
+		u      NullUUID
+	}{
+		{amount: str("12.34"), i: NullInterval{Interval: Interval{Days: 1}, Valid: true}, u: NullUUID{UUID: id, Valid: true}},
+		{},
+		{amount: str("0.05"), i: NullInterval{Interval: Interval{Months: 2}, Valid: true}, u: NullUUID{UUID: id, Valid: true}},
+		{amount: str("-0.99")},
+		{i: NullInterval{Interval: Interval{Days: 3}, Valid: true}, u: NullUUID{UUID: id, Valid: true}},
+		{i: NullInterval{Interval: Interval{Days: 3}, Valid: true}},
+		{},
+	}
+	i := 0
+	for res.Next() {
+		var amount *string
+		var interval NullInterval
+		var u NullUUID
+		require.NoError(t, res.Scan(&amount, &interval, &u))
+		require.Equal(t, expected[i].amount, amount)
+		require.Equal(t, expected[i].i, interval)
+		require.Equal(t, expected[i].u, u)
+		i++
+	}
+	require.Equal(t, len(expected), i)
+	require.NoError(t, res.Close())
+	cleanupAppender(t, c, con, a)
+}
+
 func TestAppenderNullList(t *testing.T) {
 	t.Parallel()
 	c, con, a := prepareAppender(t, `CREATE TABLE test (int_slice VARCHAR[][][])`)
@@ -351,6 +694,115 @@ func TestAppenderNullList(t *testing.T) {
 	cleanupAppender(t, c, con, a)
 }
 
+func TestAppenderEnum(t *testing.T) {
+	t.Parallel()
+
+	// An ENUM with more than 256 members uses a wider internal type.
+	var members []string
+	for i := 0; i < 300; i++ {
+		members = append(members, fmt.Sprintf("'m%d'", i))
+	}
+	c, con, a := prepareAppender(t, `CREATE TYPE greeting AS ENUM ('hello', 'world', '!');
+		CREATE TYPE large AS ENUM (`+strings.Join(members, ", ")+`);
+		CREATE TABLE test (g greeting, l large)`)
+
+	// Append the members by name, and by their dictionary index.
+	require.NoError(t, a.AppendRow("hello", "m0"))
+	require.NoError(t, a.AppendRow(1, "m299"))
+	require.NoError(t, a.AppendRow(uint8(2), uint16(257)))
+	require.NoError(t, a.AppendRow(nil, int64(42)))
+	require.NoError(t, a.Flush())
+
+	res, err := sql.OpenDB(c).QueryContext(context.Background(), `SELECT g, l FROM test ORDER BY rowid`)
+	require.NoError(t, err)
+	expected := [][2]any{{"hello", "m0"}, {"world", "m299"}, {"!", "m257"}, {nil, "m42"}}
+	i := 0
+	for res.Next() {
+		var g, l any
+		require.NoError(t, res.Scan(&g, &l))
+		require.Equal(t, expected[i], [2]any{g, l})
+		i++
+	}
+	require.Equal(t, len(expected), i)
+	require.NoError(t, res.Close())
+	cleanupAppender(t, c, con, a)
+}
+
+func TestAppenderNullAllTypes(t *testing.T) {
+	t.Parallel()
+	infos := getTypeInfos(t, false)
+	var columns []string
+	for i, info := range infos {
+		columns = append(columns, fmt.Sprintf("c%d %s", i, info.String()))
+	}
+	c, con, a := prepareAppender(t, `CREATE TYPE greeting AS ENUM ('hello', 'world', '!');
+		CREATE TABLE test (`+strings.Join(columns, ", ")+`)`)
+
+	// Both nil and typed nil pointers append NULL.
+	for _, val := range []driver.Value{nil, (*int)(nil), (*string)(nil), (*Map)(nil)} {
+		row := make([]driver.Value, len(infos))
+		for i := range row {
+			row[i] = val
+		}
+		require.NoError(t, a.AppendRow(row...))
+	}
+	require.NoError(t, a.Flush())
+
+	db := sql.OpenDB(c)
+	for i, info := range infos {
+		var count int
+		row := db.QueryRowContext(context.Background(), fmt.Sprintf(`SELECT COUNT(*) FROM test WHERE c%d IS NULL`, i))
+		require.NoError(t, row.Scan(&count))
+		require.Equal(t, 4, count, info.String())
+	}
+	cleanupAppender(t, c, con, a)
+}
+
+func TestAppenderNullNested(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (
+		l INTEGER[],
+		s STRUCT(a INTEGER, b VARCHAR),
+		m MAP(VARCHAR, INTEGER),
+		arr INTEGER[2],
+		u UNION(num INTEGER, str VARCHAR)
+	)`)
+
+	type nullFields struct {
+		A *int32  `db:"a"`
+		B *string `db:"b"`
+	}
+
+	// A NULL value, and a value with NULL elements.
+	// A NULL STRUCT differs from a STRUCT of NULL fields, and a NULL UNION differs from a NULL member.
+	require.NoError(t, a.AppendRow(nil, nil, nil, nil, nil))
+	require.NoError(t, a.AppendRow([]any{nil, 1}, nullFields{}, Map{"k": nil}, []*int32{nil, nil}, Union{Tag: "num"}))
+	require.NoError(t, a.AppendRow([]*int32{nil}, map[string]any{"a": nil, "b": nil}, map[string]*int32{"k": nil}, (*[]int32)(nil), (*Union)(nil)))
+	require.NoError(t, a.Flush())
+
+	res, err := sql.OpenDB(c).QueryContext(context.Background(), `SELECT
+			l IS NULL, l[1] IS NULL, s IS NULL, s.a IS NULL AND s.b IS NULL,
+			m IS NULL, map_values(m) IS NOT DISTINCT FROM [NULL::INTEGER], arr IS NULL, arr[1] IS NULL AND arr[2] IS NULL, u IS NULL
+		FROM test ORDER BY rowid`)
+	require.NoError(t, err)
+
+	expected := [][]bool{
+		{true, true, true, true, true, false, true, true, true},
+		{false, true, false, true, false, true, false, true, false},
+		{false, true, false, true, false, true, true, true, true},
+	}
+	i := 0
+	for res.Next() {
+		r := make([]bool, 9)
+		require.NoError(t, res.Scan(&r[0], &r[1], &r[2], &r[3], &r[4], &r[5], &r[6], &r[7], &r[8]))
+		require.Equal(t, expected[i], r, fmt.Sprintf("row %d", i))
+		i++
+	}
+	require.Equal(t, len(expected), i)
+	require.NoError(t, res.Close())
+	cleanupAppender(t, c, con, a)
+}
+
 func TestAppenderNullStruct(t *testing.T) {
 	t.Parallel()
 	c, con, a := prepareAppender(t, `
@@ -436,6 +888,64 @@ func TestAppenderNestedNullStruct(t *testing.T) {
 	cleanupAppender(t, c, con, a)
 }
 
+func TestAppenderStructFromGo(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `
+	CREATE TABLE test (
+		id INT,
+		wrapped STRUCT(A VARCHAR, B STRUCT(a INT, B VARCHAR))
+	)`)
+
+	type tagged struct {
+		Name  string       `db:"A"`
+		Inner simpleStruct `db:"B"`
+		// Unexported fields are ignored.
+		ignored int
+	}
+
+	rows := []any{
+		wrappedSimpleStruct{"hello", simpleStruct{1, "world"}},
+		&wrappedSimpleStruct{"pointer", simpleStruct{2, "value"}},
+		tagged{Name: "tagged", Inner: simpleStruct{3, "fields"}},
+		map[string]any{"A": "map", "B": map[string]any{"a": int32(4), "B": "value"}},
+		map[string]any{"A": "typed map", "B": map[string]string{"a": "5", "B": "value"}},
+		(*wrappedSimpleStruct)(nil),
+	}
+	for i, row := range rows {
+		if i == 4 {
+			// The typed map's INT value is a string, which is a cast error.
+			testError(t, a.AppendRow(i, row), errAppenderAppendRow.Error(), castErrMsg)
+			continue
+		}
+		require.NoError(t, a.AppendRow(i, row))
+	}
+	require.NoError(t, a.Flush())
+
+	// Verify results.
+	res, err := sql.OpenDB(c).QueryContext(context.Background(), `SELECT wrapped::VARCHAR FROM test ORDER BY id`)
+	require.NoError(t, err)
+
+	expected := []sql.NullString{
+		{String: `{'A': hello, 'B': {'a': 1, 'B': world}}`, Valid: true},
+		{String: `{'A': pointer, 'B': {'a': 2, 'B': value}}`, Valid: true},
+		{String: `{'A': tagged, 'B': {'a': 3, 'B': fields}}`, Valid: true},
+		{String: `{'A': map, 'B': {'a': 4, 'B': value}}`, Valid: true},
+		{},
+	}
+
+	i := 0
+	for res.Next() {
+		var row sql.NullString
+		require.NoError(t, res.Scan(&row))
+		require.Equal(t, expected[i], row)
+		i++
+	}
+	require.Equal(t, len(expected), i)
+
+	require.NoError(t, res.Close())
+	cleanupAppender(t, c, con, a)
+}
+
 func TestAppenderNullIntAndString(t *testing.T) {
 	t.Parallel()
 	c, con, a := prepareAppender(t, `CREATE TABLE test (id BIGINT, str VARCHAR)`)
@@ -487,6 +997,15 @@ func TestAppenderUUID(t *testing.T) {
 	require.NoError(t, a.AppendRow(&otherId))
 	require.NoError(t, a.AppendRow((*UUID)(nil)))
 	require.NoError(t, a.AppendRow(nil))
+	googleId := uuid.New()
+	require.NoError(t, a.AppendRow(googleId))
+	require.NoError(t, a.AppendRow(googleId.String()))
+	require.Error(t, a.AppendRow("not a UUID"))
+	require.NoError(t, a.AppendRow([16]byte(googleId)))
+
+	// Values with a width other than 16 bytes fail to append.
+	testError(t, a.AppendRow(googleId[:15]), errAppenderAppendRow.Error(), castErrMsg)
+	testError(t, a.AppendRow([15]byte{}), errAppenderAppendRow.Error(), castErrMsg)
 	require.NoError(t, a.Flush())
 
 	// Verify results.
@@ -495,7 +1014,11 @@ func TestAppenderUUID(t *testing.T) {
 
 	i := 0
 	for res.Next() {
-		if i == 0 {
+		if i >= 4 {
+			var r uuid.UUID
+			require.NoError(t, res.Scan(&r))
+			require.Equal(t, googleId, r)
+		} else if i == 0 {
 			var r UUID
 			require.NoError(t, res.Scan(&r))
 			require.Equal(t, id, r)
@@ -513,6 +1036,159 @@ func TestAppenderUUID(t *testing.T) {
 	cleanupAppender(t, c, con, a)
 }
 
+func TestAppenderMap(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `
+	CREATE TABLE test (
+		id INT,
+		m MAP(VARCHAR, INT),
+		d MAP(DECIMAL(3, 2), VARCHAR)
+	)`)
+
+	require.NoError(t, a.AppendRow(0, map[string]int32{"a": 1, "b": 2}, map[string]string{"4": "four"}))
+	require.NoError(t, a.AppendRow(1, map[string]any{"a": nil}, map[*big.Rat]string{big.NewRat(5, 4): "five quarters"}))
+	require.NoError(t, a.AppendRow(2, Map{"c": int32(3)}, map[string]any{"-1.5": nil}))
+	require.NoError(t, a.AppendRow(3, map[string]int32{}, nil))
+	require.NoError(t, a.Flush())
+
+	// Verify results.
+	res, err := sql.OpenDB(c).QueryContext(context.Background(), `
+		SELECT list_sort(map_entries(m))::VARCHAR, d::VARCHAR FROM test ORDER BY id`)
+	require.NoError(t, err)
+
+	expected := [][]sql.NullString{
+		{{String: `[{'key': a, 'value': 1}, {'key': b, 'value': 2}]`, Valid: true}, {String: `{4.00=four}`, Valid: true}},
+		{{String: `[{'key': a, 'value': NULL}]`, Valid: true}, {String: `{1.25=five quarters}`, Valid: true}},
+		{{String: `[{'key': c, 'value': 3}]`, Valid: true}, {String: `{-1.50=NULL}`, Valid: true}},
+		{{String: `[]`, Valid: true}, {}},
+	}
+
+	i := 0
+	for res.Next() {
+		var m, d sql.NullString
+		require.NoError(t, res.Scan(&m, &d))
+		require.Equal(t, expected[i], []sql.NullString{m, d})
+		i++
+	}
+	require.Equal(t, len(expected), i)
+
+	require.NoError(t, res.Close())
+	cleanupAppender(t, c, con, a)
+}
+
+func TestAppenderDecimalConversions(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (d DECIMAL(4, 2), h DECIMAL(38, 10))`)
+
+	require.NoError(t, a.AppendRow("12.34", "-1234567890123456789012345678.0123456789"))
+	require.NoError(t, a.AppendRow(big.NewRat(-1, 4), big.NewRat(1, 1024)))
+	require.NoError(t, a.Flush())
+
+	// Values must fit into the DECIMAL without rounding.
+	err := a.AppendRow("123.4", "0")
+	testError(t, err, errAppenderAppendRow.Error(), invalidInputErrMsg, "DECIMAL(4,2)")
+	err = a.AppendRow("1.234", "0")
+	testError(t, err, errAppenderAppendRow.Error(), invalidInputErrMsg, "DECIMAL(4,2)")
+	err = a.AppendRow("1.2.3", "0")
+	testError(t, err, errAppenderAppendRow.Error(), invalidInputErrMsg)
+
+	// A nil *big.Rat appends NULL.
+	require.NoError(t, a.AppendRow((*big.Rat)(nil), "0"))
+
+	// Verify results.
+	db := sql.OpenDB(c)
+	var d, h string
+	require.NoError(t, db.QueryRow(`SELECT d::VARCHAR, h::VARCHAR FROM test WHERE d > 0`).Scan(&d, &h))
+	require.Equal(t, "12.34", d)
+	require.Equal(t, "-1234567890123456789012345678.0123456789", h)
+	require.NoError(t, db.QueryRow(`SELECT d::VARCHAR, h::VARCHAR FROM test WHERE d < 0`).Scan(&d, &h))
+	require.Equal(t, "-0.25", d)
+	require.Equal(t, "0.0009765625", h)
+	cleanupAppender(t, c, con, a)
+}
+
+func TestAppenderUnion(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (id INT, u UNION(num INTEGER, str VARCHAR, list INTEGER[]))`)
+
+	require.NoError(t, a.AppendRow(int32(0), Union{Tag: "num", Value: int32(42)}))
+	require.NoError(t, a.AppendRow(int32(1), &Union{Tag: "str", Value: "hello"}))
+	require.NoError(t, a.AppendRow(int32(2), Union{Tag: "list", Value: []int32{1, 2}}))
+	require.NoError(t, a.AppendRow(int32(3), Union{Tag: "str"}))
+	require.NoError(t, a.AppendRow(int32(4), nil))
+	require.NoError(t, a.Flush())
+
+	// Verify results.
+	res, err := sql.OpenDB(c).QueryContext(context.Background(), `SELECT union_tag(u), u::VARCHAR FROM test ORDER BY id`)
+	require.NoError(t, err)
+
+	expected := [][2]sql.NullString{
+		{{String: "num", Valid: true}, {String: "42", Valid: true}},
+		{{String: "str", Valid: true}, {String: "hello", Valid: true}},
+		{{String: "list", Valid: true}, {String: "[1, 2]", Valid: true}},
+		{{String: "str", Valid: true}, {String: "NULL", Valid: true}},
+		{{}, {}},
+	}
+	i := 0
+	for res.Next() {
+		var tag, val sql.NullString
+		require.NoError(t, res.Scan(&tag, &val))
+		require.Equal(t, expected[i][0], tag)
+		require.Equal(t, expected[i][1], val)
+		i++
+	}
+	require.Equal(t, len(expected), i)
+	require.NoError(t, res.Close())
+
+	// Unknown member names fail.
+	err = a.AppendRow(int32(5), Union{Tag: "other", Value: 1})
+	testError(t, err, errAppenderAppendRow.Error(), invalidInputErrMsg)
+	cleanupAppender(t, c, con, a)
+}
+
+func TestAppenderBit(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (id INT, b BIT)`)
+
+	long := strings.Repeat("0110", 20) + "1"
+	expected := []any{Bit("1"), Bit("00101"), Bit("00000000"), Bit("000000001"), Bit(long), nil}
+	for i, val := range expected {
+		require.NoError(t, a.AppendRow(int32(i), val))
+	}
+	// Plain strings are also accepted.
+	require.NoError(t, a.AppendRow(int32(len(expected)), "0011"))
+	expected = append(expected, Bit("0011"))
+	require.NoError(t, a.Flush())
+
+	// Verify results.
+	res, err := sql.OpenDB(c).QueryContext(context.Background(), `SELECT b, b::VARCHAR FROM test ORDER BY id`)
+	require.NoError(t, err)
+
+	i := 0
+	for res.Next() {
+		var b *Bit
+		var str sql.NullString
+		require.NoError(t, res.Scan(&b, &str))
+		if expected[i] == nil {
+			require.Nil(t, b)
+			require.False(t, str.Valid)
+		} else {
+			require.Equal(t, expected[i], *b)
+			require.Equal(t, string(expected[i].(Bit)), str.String)
+		}
+		i++
+	}
+	require.Equal(t, len(expected), i)
+	require.NoError(t, res.Close())
+
+	// Empty and invalid bitstrings fail.
+	err = a.AppendRow(int32(42), Bit(""))
+	testError(t, err, errAppenderAppendRow.Error(), invalidInputErrMsg)
+	err = a.AppendRow(int32(42), "0120")
+	testError(t, err, errAppenderAppendRow.Error(), invalidInputErrMsg)
+	cleanupAppender(t, c, con, a)
+}
+
 func newAppenderHugeIntTest[T numericType](val T, c *Connector, a *Appender) func(t *testing.T) {
 	return func(t *testing.T) {
 		typeName := reflect.TypeOf(val).String()
@@ -549,6 +1225,45 @@ func TestAppenderHugeInt(t *testing.T) {
 	cleanupAppender(t, c, con, a)
 }
 
+func TestAppenderHugeIntBounds(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (id INTEGER, h HUGEINT, u UHUGEINT)`)
+
+	one := big.NewInt(1)
+	maxHuge := new(big.Int).Sub(new(big.Int).Lsh(one, 127), one)
+	minHuge := new(big.Int).Neg(new(big.Int).Lsh(one, 127))
+	maxUhuge := new(big.Int).Sub(new(big.Int).Lsh(one, 128), one)
+
+	require.NoError(t, a.AppendRow(int32(0), maxHuge, maxUhuge))
+	require.NoError(t, a.AppendRow(int32(1), minHuge, big.NewInt(0)))
+	require.NoError(t, a.AppendRow(int32(2), big.NewInt(-1), new(big.Int).Lsh(one, 64)))
+
+	// Values exceeding the 128-bit widths fail to append.
+	tooBig := new(big.Int).Lsh(one, 127)
+	testError(t, a.AppendRow(int32(3), tooBig, big.NewInt(0)), errAppenderAppendRow.Error(), "too big for HUGEINT")
+	tooSmall := new(big.Int).Sub(minHuge, one)
+	testError(t, a.AppendRow(int32(3), tooSmall, big.NewInt(0)), errAppenderAppendRow.Error(), "too big for HUGEINT")
+	tooBig = new(big.Int).Lsh(one, 128)
+	testError(t, a.AppendRow(int32(3), big.NewInt(0), tooBig), errAppenderAppendRow.Error(), "too big for UHUGEINT")
+	testError(t, a.AppendRow(int32(3), big.NewInt(0), big.NewInt(-1)), errAppenderAppendRow.Error(), "negative")
+	require.NoError(t, a.Flush())
+
+	res, err := sql.OpenDB(c).QueryContext(context.Background(), `SELECT h, u FROM test ORDER BY id`)
+	require.NoError(t, err)
+	expected := [][2]*big.Int{{maxHuge, maxUhuge}, {minHuge, big.NewInt(0)}, {big.NewInt(-1), new(big.Int).Lsh(one, 64)}}
+	i := 0
+	for res.Next() {
+		var h, u *big.Int
+		require.NoError(t, res.Scan(&h, &u))
+		require.Zero(t, expected[i][0].Cmp(h), h.String())
+		require.Zero(t, expected[i][1].Cmp(u), u.String())
+		i++
+	}
+	require.Equal(t, len(expected), i)
+	require.NoError(t, res.Close())
+	cleanupAppender(t, c, con, a)
+}
+
 func TestAppenderTsNs(t *testing.T) {
 	t.Parallel()
 	c, con, a := prepareAppender(t, `CREATE TABLE test (timestamp TIMESTAMP_NS)`)
@@ -619,6 +1334,16 @@ func TestAppenderTimeTZ(t *testing.T) {
 	require.NoError(t, row.Scan(&res))
 	base := time.Date(1, time.January, 1, 3, 42, 23, 123000, time.UTC)
 	require.Equal(t, base.UnixMicro(), res.UnixMicro())
+
+	// The UTC offset is preserved.
+	require.Equal(t, 11, res.Hour())
+	_, offset := res.Zone()
+	require.Equal(t, 8*60*60, offset)
+
+	var str string
+	row = sql.OpenDB(c).QueryRowContext(context.Background(), `SELECT time::VARCHAR FROM test`)
+	require.NoError(t, row.Scan(&str))
+	require.Equal(t, "11:42:23.000123+08", str)
 	cleanupAppender(t, c, con, a)
 }
 
@@ -651,6 +1376,65 @@ func TestAppenderBlob(t *testing.T) {
 	cleanupAppender(t, c, con, a)
 }
 
+// testReadCloser is an io.ReadCloser, which records whether it was closed.
+type testReadCloser struct {
+	io.Reader
+	closed bool
+}
+
+func (r *testReadCloser) Close() error {
+	r.closed = true
+	return nil
+}
+
+func TestAppenderBlobReader(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (id INT, data BLOB, str VARCHAR)`)
+
+	// Append multi-MB values from readers.
+	large := make([]byte, 5<<20)
+	for i := range large {
+		large[i] = byte(randInt(0, 255))
+	}
+	closer := &testReadCloser{Reader: bytes.NewReader(large)}
+	require.NoError(t, a.AppendRow(int32(0), closer, strings.NewReader("hello")))
+	require.True(t, closer.closed)
+	require.NoError(t, a.AppendRow(int32(1), bytes.NewReader(nil), nil))
+
+	// Failing readers are closed, and return their error.
+	failing := &testReadCloser{Reader: iotest.ErrReader(errors.New("test read error"))}
+	err := a.AppendRow(int32(2), failing, "world")
+	testError(t, err, errAppenderAppendRow.Error(), "test read error")
+	require.True(t, failing.closed)
+	require.NoError(t, a.Flush())
+
+	res, err := sql.OpenDB(c).QueryContext(context.Background(), `SELECT data, str FROM test ORDER BY id`)
+	require.NoError(t, err)
+
+	require.True(t, res.Next())
+	var data, str BlobReader
+	require.NoError(t, res.Scan(&data, &str))
+	require.True(t, data.Valid)
+	b, err := io.ReadAll(&data)
+	require.NoError(t, err)
+	require.Equal(t, large, b)
+	b, err = io.ReadAll(&str)
+	require.NoError(t, err)
+	require.Equal(t, "hello", string(b))
+
+	// A NULL value scans into an invalid, empty reader.
+	require.True(t, res.Next())
+	require.NoError(t, res.Scan(&data, &str))
+	require.True(t, data.Valid)
+	require.Equal(t, 0, data.Len())
+	require.False(t, str.Valid)
+	require.Equal(t, 0, str.Len())
+
+	require.False(t, res.Next())
+	require.NoError(t, res.Close())
+	cleanupAppender(t, c, con, a)
+}
+
 func TestAppenderBlobTinyInt(t *testing.T) {
 	t.Parallel()
 	c, con, a := prepareAppender(t, `
@@ -936,3 +1720,120 @@ func appendNestedData[T require.TestingT](t T, a *Appender, rowsToAppend []neste
 	}
 	require.NoError(t, a.Flush())
 }
+
+func TestAppenderTransaction(t *testing.T) {
+	t.Parallel()
+	c, err := NewConnector("", nil)
+	require.NoError(t, err)
+	db := sql.OpenDB(c)
+	createTable(db, t, `CREATE TABLE test (id INTEGER PRIMARY KEY)`)
+
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	require.NoError(t, err)
+
+	newAppender := func() *Appender {
+		var a *Appender
+		err := conn.Raw(func(driverConn any) error {
+			var err error
+			a, err = NewAppenderFromConn(driverConn.(driver.Conn), "", "test")
+			return err
+		})
+		require.NoError(t, err)
+		return a
+	}
+	count := func() int {
+		var n int
+		require.NoError(t, db.QueryRow(`SELECT count(*) FROM test`).Scan(&n))
+		return n
+	}
+
+	// Rolling back discards the rows, including the rows passed to DuckDB.
+	tx, err := conn.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	a := newAppender()
+	for i := 0; i < GetDataChunkCapacity()+10; i++ {
+		require.NoError(t, a.AppendRow(int32(i)))
+	}
+	require.NoError(t, tx.Rollback())
+	require.Equal(t, 0, count())
+	testError(t, a.AppendRow(int32(1)), errAppenderAppendRow.Error(), errAppenderTxEnded.Error())
+	testError(t, a.Flush(), errAppenderFlush.Error(), errAppenderTxEnded.Error())
+	require.NoError(t, a.Close())
+	require.Equal(t, 0, count())
+
+	// Committing flushes the rows.
+	tx, err = conn.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	a = newAppender()
+	require.NoError(t, a.AppendRow(int32(1)))
+	require.NoError(t, a.AppendRow(int32(2)))
+	require.Equal(t, 0, count())
+	require.NoError(t, tx.Commit())
+	require.Equal(t, 2, count())
+	require.NoError(t, a.Close())
+	require.Equal(t, 2, count())
+
+	// A failed flush rolls back the transaction.
+	tx, err = conn.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	_, err = tx.Exec(`INSERT INTO test VALUES (3)`)
+	require.NoError(t, err)
+	a = newAppender()
+	require.NoError(t, a.AppendRow(int32(1)))
+	testError(t, tx.Commit(), errAppenderFlush.Error())
+	require.Equal(t, 2, count())
+	testError(t, a.Close(), errAppenderClose.Error())
+
+	require.NoError(t, conn.Close())
+	require.NoError(t, db.Close())
+}
+
+func ExampleNewAppenderFromConn() {
+	c, err := NewConnector("", nil)
+	if err != nil {
+		log.Fatalf("failed to create new duckdb connector: %s", err)
+	}
+	defer c.Close()
+
+	db := sql.OpenDB(c)
+	defer db.Close()
+	if _, err = db.Exec(`CREATE TABLE users (id INTEGER, tags VARCHAR[], address STRUCT(city VARCHAR, zip INTEGER))`); err != nil {
+		log.Fatalf("failed to create table: %s", err)
+	}
+
+	con, err := c.Connect(context.Background())
+	if err != nil {
+		log.Fatalf("failed to connect: %s", err)
+	}
+	defer con.Close()
+
+	a, err := NewAppenderFromConn(con, "", "users")
+	if err != nil {
+		log.Fatalf("failed to create new appender: %s", err)
+	}
+
+	address := map[string]any{"city": "Amsterdam", "zip": 1012}
+	if err = a.AppendRow(int32(1), []string{"admin", "dev"}, address); err != nil {
+		log.Fatalf("failed to append row: %s", err)
+	}
+
+	// The value types must match the column types.
+	err = a.AppendRow("2", []string{}, address)
+	fmt.Println(err != nil)
+
+	// Close flushes all appended rows to the table.
+	if err = a.Close(); err != nil {
+		log.Fatalf("failed to close the appender: %s", err)
+	}
+
+	var tags, city string
+	row := db.QueryRow(`SELECT tags::VARCHAR, address.city FROM users WHERE id = 1`)
+	if err = row.Scan(&tags, &city); err != nil {
+		log.Fatalf("failed to scan row: %s", err)
+	}
+	fmt.Println(tags, city)
+	// Output:
+	// true
+	// [admin, dev] Amsterdam
+}
@@ -64,6 +64,7 @@ import (
 	"database/sql/driver"
 	"errors"
 	"fmt"
+	"sync/atomic"
 	"unsafe"
 
 	"github.com/apache/arrow-go/v18/arrow"
@@ -94,38 +95,7 @@ func NewArrowFromConn(driverConn driver.Conn) (*Arrow, error) {
 // QueryContext prepares statements, executes them, returns Apache Arrow array.RecordReader as a result of the last
 // executed statement. Arguments are bound to the last statement.
 func (a *Arrow) QueryContext(ctx context.Context, query string, args ...any) (array.RecordReader, error) {
-	if a.c.closed {
-		return nil, errClosedCon
-	}
-
-	stmts, size, err := a.c.extractStmts(query)
-	if err != nil {
-		return nil, err
-	}
-	defer C.duckdb_destroy_extracted(&stmts)
-
-	// execute all statements without args, except the last one
-	for i := C.idx_t(0); i < size-1; i++ {
-		stmt, err := a.c.prepareExtractedStmt(stmts, i)
-		if err != nil {
-			return nil, err
-		}
-		// send nil args to execute statement and ignore result (using ExecContext since we're ignoring the result anyway)
-		_, err = stmt.ExecContext(ctx, nil)
-		stmt.Close()
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	// prepare and execute last statement with args and return result
-	stmt, err := a.c.prepareExtractedStmt(stmts, size-1)
-	if err != nil {
-		return nil, err
-	}
-	defer stmt.Close()
-
-	res, err := a.execute(stmt, a.anyArgsToNamedArgs(args))
+	res, err := a.executeStmts(ctx, query, args)
 	if err != nil {
 		return nil, err
 	}
@@ -167,6 +137,138 @@ func (a *Arrow) QueryContext(ctx context.Context, query string, args ...any) (ar
 	return array.NewRecordReader(sc, recs)
 }
 
+// QueryStreamContext is like QueryContext, but it returns an array.RecordReader, which converts
+// each record lazily when calling Next. Thus, only the current record occupies Go memory.
+// The reader keeps the DuckDB result alive until its last reference is released,
+// so you must call Release after reading the records.
+// If ctx is canceled, then Next returns false, and Err returns the context's error.
+func (a *Arrow) QueryStreamContext(ctx context.Context, query string, args ...any) (array.RecordReader, error) {
+	res, err := a.executeStmts(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	sc, err := a.queryArrowSchema(res)
+	if err != nil {
+		C.duckdb_destroy_arrow(res)
+		return nil, err
+	}
+
+	return &arrowStreamReader{
+		a:        a,
+		ctx:      ctx,
+		refs:     1,
+		res:      res,
+		schema:   sc,
+		rowCount: uint64(C.duckdb_arrow_row_count(*res)),
+	}, nil
+}
+
+// executeStmts prepares and executes all statements of the query, and returns the result of the last statement.
+// The caller must destroy the result.
+func (a *Arrow) executeStmts(ctx context.Context, query string, args []any) (*C.duckdb_arrow, error) {
+	if a.c.closed {
+		return nil, errClosedCon
+	}
+
+	stmts, size, err := a.c.extractStmts(query)
+	if err != nil {
+		return nil, err
+	}
+	defer C.duckdb_destroy_extracted(&stmts)
+
+	// execute all statements without args, except the last one
+	for i := C.idx_t(0); i < size-1; i++ {
+		stmt, err := a.c.prepareExtractedStmt(stmts, i)
+		if err != nil {
+			return nil, err
+		}
+		// send nil args to execute statement and ignore result (using ExecContext since we're ignoring the result anyway)
+		_, err = stmt.ExecContext(ctx, nil)
+		stmt.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// prepare and execute last statement with args and return result
+	stmt, err := a.c.prepareExtractedStmt(stmts, size-1)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+
+	return a.execute(stmt, a.anyArgsToNamedArgs(args))
+}
+
+// arrowStreamReader is an array.RecordReader fetching the records of a DuckDB Arrow result one at a time.
+type arrowStreamReader struct {
+	a      *Arrow
+	ctx    context.Context
+	refs   int64
+	res    *C.duckdb_arrow
+	schema *arrow.Schema
+	rec    arrow.Record
+	err    error
+
+	rowCount      uint64
+	retrievedRows uint64
+}
+
+// Retain increases the reference count by 1.
+func (r *arrowStreamReader) Retain() {
+	atomic.AddInt64(&r.refs, 1)
+}
+
+// Release decreases the reference count by 1.
+// When the reference count drops to zero, it releases the current record and the DuckDB result.
+func (r *arrowStreamReader) Release() {
+	if atomic.AddInt64(&r.refs, -1) != 0 {
+		return
+	}
+	if r.rec != nil {
+		r.rec.Release()
+		r.rec = nil
+	}
+	C.duckdb_destroy_arrow(r.res)
+}
+
+// Schema returns the schema of the result.
+func (r *arrowStreamReader) Schema() *arrow.Schema {
+	return r.schema
+}
+
+// Next fetches the next record. It returns false, if there are no more records, or on error.
+func (r *arrowStreamReader) Next() bool {
+	if r.rec != nil {
+		r.rec.Release()
+		r.rec = nil
+	}
+	if r.err != nil || r.retrievedRows >= r.rowCount {
+		return false
+	}
+	if r.err = r.ctx.Err(); r.err != nil {
+		return false
+	}
+
+	r.rec, r.err = r.a.queryArrowArray(r.res, r.schema)
+	if r.err != nil {
+		return false
+	}
+	r.retrievedRows += uint64(r.rec.NumRows())
+	return true
+}
+
+// Record returns the current record. It is only valid until the next call to Next.
+func (r *arrowStreamReader) Record() arrow.Record {
+	return r.rec
+}
+
+// Err returns the error, if any, that stopped the iteration.
+func (r *arrowStreamReader) Err() error {
+	return r.err
+}
+
 // queryArrowSchema fetches the internal arrow schema from the arrow result.
 func (a *Arrow) queryArrowSchema(res *C.duckdb_arrow) (*arrow.Schema, error) {
 	schema := C.calloc(1, C.sizeof_struct_ArrowSchema)
@@ -229,7 +331,7 @@ func (a *Arrow) execute(s *Stmt, args []driver.NamedValue) (*C.duckdb_arrow, err
 	if state := C.duckdb_execute_prepared_arrow(*s.stmt, &res); state == C.DuckDBError {
 		dbErr := C.GoString(C.duckdb_query_arrow_error(res))
 		C.duckdb_destroy_arrow(&res)
-		return nil, fmt.Errorf("duckdb_execute_prepared_arrow: %v", dbErr)
+		return nil, fmt.Errorf("duckdb_execute_prepared_arrow: %w", getDuckDBError(dbErr))
 	}
 
 	return &res, nil
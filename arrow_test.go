@@ -210,6 +210,67 @@ func TestArrow(t *testing.T) {
 	})
 }
 
+func TestArrowStream(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewConnector("", nil)
+	require.NoError(t, err)
+	defer c.Close()
+
+	conn, err := c.Connect(context.Background())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	ar, err := NewArrowFromConn(conn)
+	require.NoError(t, err)
+
+	t.Run("nested types", func(t *testing.T) {
+		rdr, err := ar.QueryStreamContext(context.Background(), `
+			SELECT range AS i,
+				[range, range + 1] AS l,
+				{'a': range, 'b': range::VARCHAR} AS s,
+				MAP {'key': range} AS m
+			FROM range(?)`, 10000)
+		require.NoError(t, err)
+		defer rdr.Release()
+
+		schema := rdr.Schema()
+		require.Equal(t, arrow.INT64, schema.Field(0).Type.ID())
+		require.Equal(t, arrow.LIST, schema.Field(1).Type.ID())
+		require.Equal(t, arrow.STRUCT, schema.Field(2).Type.ID())
+		require.Equal(t, arrow.MAP, schema.Field(3).Type.ID())
+
+		var records, totalRows int64
+		for rdr.Next() {
+			rec := rdr.Record()
+			col := rec.Column(0).(*array.Int64)
+			require.Equal(t, totalRows, col.Value(0))
+			records++
+			totalRows += rec.NumRows()
+		}
+		require.NoError(t, rdr.Err())
+		require.Equal(t, int64(10000), totalRows)
+		require.Greater(t, records, int64(1))
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		rdr, err := ar.QueryStreamContext(ctx, `SELECT * FROM range(10000)`)
+		require.NoError(t, err)
+		defer rdr.Release()
+
+		require.True(t, rdr.Next())
+		cancel()
+		require.False(t, rdr.Next())
+		require.ErrorIs(t, rdr.Err(), context.Canceled)
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		_, err := ar.QueryStreamContext(context.Background(), `SELECT * FROM does_not_exist`)
+		require.ErrorContains(t, err, "does_not_exist")
+	})
+}
+
 func TestArrowClosedConn(t *testing.T) {
 	db := openDB(t)
 	defer db.Close()
@@ -260,3 +321,128 @@ func TestArrowClosedConn(t *testing.T) {
 	})
 	require.Error(t, err)
 }
+
+func TestAppendArrow(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewConnector("", nil)
+	require.NoError(t, err)
+	defer c.Close()
+
+	conn, err := c.Connect(context.Background())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	db := sql.OpenDB(c)
+	defer db.Close()
+
+	_, err = conn.(*Conn).ExecContext(context.Background(), `
+		CREATE TYPE mood AS ENUM ('happy', 'sad');
+		CREATE TABLE test (i BIGINT, l INTEGER[], s STRUCT(a VARCHAR), m mood)`, nil)
+	require.NoError(t, err)
+
+	a, err := NewAppenderFromConn(conn, "", "test")
+	require.NoError(t, err)
+
+	pool := memory.NewGoAllocator()
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "i", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "l", Type: arrow.ListOf(arrow.PrimitiveTypes.Int32)},
+		{Name: "s", Type: arrow.StructOf(arrow.Field{Name: "a", Type: arrow.BinaryTypes.String, Nullable: true})},
+		{Name: "m", Type: &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int8, ValueType: arrow.BinaryTypes.String}},
+	}, nil)
+
+	b := array.NewRecordBuilder(pool, schema)
+	defer b.Release()
+	b.Field(0).(*array.Int64Builder).AppendValues([]int64{1, 2}, nil)
+	lb := b.Field(1).(*array.ListBuilder)
+	lb.Append(true)
+	lb.ValueBuilder().(*array.Int32Builder).AppendValues([]int32{1, 2}, nil)
+	lb.AppendNull()
+	sb := b.Field(2).(*array.StructBuilder)
+	sb.Append(true)
+	sb.FieldBuilder(0).(*array.StringBuilder).Append("x")
+	sb.Append(true)
+	sb.FieldBuilder(0).(*array.StringBuilder).AppendNull()
+	db0 := b.Field(3).(*array.BinaryDictionaryBuilder)
+	require.NoError(t, db0.AppendString("sad"))
+	require.NoError(t, db0.AppendString("happy"))
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	// Appending the record keeps the order of previously appended rows.
+	require.NoError(t, a.AppendRow(int64(0), nil, nil, "happy"))
+	require.NoError(t, a.AppendArrow(rec))
+	require.NoError(t, a.AppendRow(int64(3), []any{int32(3)}, nil, nil))
+	require.NoError(t, a.Close())
+
+	rows, err := db.Query(`SELECT i, l::VARCHAR, s::VARCHAR, m FROM test ORDER BY rowid`)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	type row struct {
+		i       int64
+		l, s, m *string
+	}
+	str := func(s string) *string { return &s }
+	expected := []row{
+		{0, nil, nil, str("happy")},
+		{1, str("[1, 2]"), str("{'a': x}"), str("sad")},
+		{2, nil, str("{'a': NULL}"), str("happy")},
+		{3, str("[3]"), nil, nil},
+	}
+	var actual []row
+	for rows.Next() {
+		var r row
+		require.NoError(t, rows.Scan(&r.i, &r.l, &r.s, &r.m))
+		actual = append(actual, r)
+	}
+	require.NoError(t, rows.Err())
+	require.Equal(t, expected, actual)
+}
+
+func TestErrAppendArrow(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewConnector("", nil)
+	require.NoError(t, err)
+	defer c.Close()
+
+	conn, err := c.Connect(context.Background())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, err = conn.(*Conn).ExecContext(context.Background(), `CREATE TABLE test (i BIGINT, l INTEGER[])`, nil)
+	require.NoError(t, err)
+
+	a, err := NewAppenderFromConn(conn, "", "test")
+	require.NoError(t, err)
+
+	pool := memory.NewGoAllocator()
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "i", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "l", Type: arrow.ListOf(arrow.BinaryTypes.String)},
+	}, nil)
+	b := array.NewRecordBuilder(pool, schema)
+	defer b.Release()
+	b.Field(0).(*array.Int64Builder).Append(1)
+	b.Field(1).(*array.ListBuilder).AppendNull()
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	err = a.AppendArrow(rec)
+	testError(t, err, errAppenderAppendArrow.Error(), castErrMsg, "VARCHAR[]", "INTEGER[]")
+
+	sb := array.NewRecordBuilder(pool, arrow.NewSchema(schema.Fields()[:1], nil))
+	defer sb.Release()
+	sb.Field(0).(*array.Int64Builder).Append(1)
+	single := sb.NewRecord()
+	defer single.Release()
+
+	err = a.AppendArrow(single)
+	testError(t, err, errAppenderAppendArrow.Error(), columnCountErrMsg)
+
+	require.NoError(t, a.Close())
+	err = a.AppendArrow(rec)
+	testError(t, err, errAppenderAppendAfterClose.Error())
+}
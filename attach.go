@@ -0,0 +1,37 @@
+package duckdb
+
+import "context"
+
+// Attach runs ATTACH on this connection to attach the database file at path, e.g., Attach("other.db", "other", false).
+// Afterward, queries can reference the tables of the attached database as alias.table. An empty alias
+// derives the alias from the file name. A path prefixed with sqlite: or postgres:, e.g., "sqlite:data.db",
+// attaches an SQLite or Postgres database via the respective extension, which DuckDB loads automatically.
+// The attached database is visible to all connections to the same DuckDB instance.
+func (c *Conn) Attach(path, alias string, readOnly bool) error {
+	if path == "" {
+		return getError(errAPI, errEmptyName)
+	}
+	query := "ATTACH " + quoteLiteral(path)
+	if alias != "" {
+		query += " AS " + quoteIdentifier(alias)
+	}
+	if readOnly {
+		query += " (READ_ONLY)"
+	}
+	if _, err := c.ExecContext(context.Background(), query, nil); err != nil {
+		return getError(errAttach, err)
+	}
+	return nil
+}
+
+// Detach runs DETACH on this connection to detach the database alias.
+// It returns an error, if the database is not attached, or if it is in use, e.g., the connection's default database.
+func (c *Conn) Detach(alias string) error {
+	if alias == "" {
+		return getError(errAPI, errEmptyName)
+	}
+	if _, err := c.ExecContext(context.Background(), "DETACH "+quoteIdentifier(alias), nil); err != nil {
+		return getError(errDetach, err)
+	}
+	return nil
+}
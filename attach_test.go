@@ -0,0 +1,65 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttach(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "other.db")
+	other, err := sql.Open("duckdb", path)
+	require.NoError(t, err)
+	_, err = other.Exec(`CREATE TABLE items AS SELECT range AS id FROM range(3)`)
+	require.NoError(t, err)
+	require.NoError(t, other.Close())
+
+	db := openDB(t)
+	conn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+
+	err = conn.Raw(func(driverConn any) error {
+		c := driverConn.(*Conn)
+		require.NoError(t, c.Attach(path, "other", true))
+		return nil
+	})
+	require.NoError(t, err)
+
+	// The attached database is visible to all connections.
+	var count int
+	require.NoError(t, db.QueryRow(`SELECT count(*) FROM other.items`).Scan(&count))
+	require.Equal(t, 3, count)
+	_, err = db.Exec(`INSERT INTO other.items VALUES (3)`)
+	require.ErrorContains(t, err, "read-only")
+
+	err = conn.Raw(func(driverConn any) error {
+		c := driverConn.(*Conn)
+
+		// The database is in use as the default database of the connection.
+		_, err := c.ExecContext(context.Background(), `USE other`, nil)
+		require.NoError(t, err)
+		err = c.Detach("other")
+		testError(t, err, errDetach.Error(), "default database")
+
+		_, err = c.ExecContext(context.Background(), `USE memory`, nil)
+		require.NoError(t, err)
+		require.NoError(t, c.Detach("other"))
+		testError(t, c.Detach("other"), errDetach.Error(), "other")
+
+		// An empty alias derives the alias from the file name.
+		require.NoError(t, c.Attach(path, "", false))
+		require.NoError(t, c.Detach("other"))
+
+		testError(t, c.Attach(filepath.Join(t.TempDir(), "dir", "x.db"), "x", true), errAttach.Error())
+		testError(t, c.Attach("", "x", false), errAPI.Error(), errEmptyName.Error())
+		testError(t, c.Detach(""), errAPI.Error(), errEmptyName.Error())
+		return nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+	require.NoError(t, db.Close())
+}
@@ -0,0 +1,149 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// bulkStagingTables counts the staging tables of bulk mutations, to create unique table names.
+var bulkStagingTables atomic.Uint64
+
+// BulkMerge updates the rows of the target table whose key columns match a row of rows, and inserts all other rows.
+// rows is a slice of Go structs, or of pointers to Go structs, whose fields match the target's columns, see
+// Appender.AppendRows. BulkMerge stages rows in a temporary table via an Appender, so that it merges them
+// with two statements. Rows with NULL keys do not match, and of multiple rows with the same keys, only the first
+// one updates a matching row, or is inserted. The target table is in the connection's current schema.
+func (c *Conn) BulkMerge(ctx context.Context, target string, rows any, keyCols []string) error {
+	return c.bulkMutate(ctx, target, rows, keyCols, func(table, stage string, columns []string) []string {
+		var sets []string
+		for _, column := range columns {
+			if !slices.Contains(keyCols, column) {
+				sets = append(sets, quoteIdentifier(column)+" = s."+quoteIdentifier(column))
+			}
+		}
+		match := keysMatch(keyCols)
+		staged := firstStagedRows(stage, keyCols)
+
+		var statements []string
+		if len(sets) != 0 {
+			statements = append(statements, "UPDATE "+table+" AS t SET "+strings.Join(sets, ", ")+
+				" FROM "+staged+" AS s WHERE "+match)
+		}
+		return append(statements, "INSERT INTO "+table+" SELECT * FROM "+staged+" AS s WHERE NOT EXISTS (SELECT 1 FROM "+
+			table+" AS t WHERE "+match+")")
+	})
+}
+
+// BulkDelete deletes the rows of the target table whose key columns match a row of rows.
+// rows is a slice of Go structs, or of pointers to Go structs, whose fields match the target's columns, see
+// Appender.AppendRows. Like BulkMerge, BulkDelete stages rows in a temporary table via an Appender.
+// The target table is in the connection's current schema.
+func (c *Conn) BulkDelete(ctx context.Context, target string, rows any, keyCols []string) error {
+	return c.bulkMutate(ctx, target, rows, keyCols, func(table, stage string, _ []string) []string {
+		return []string{"DELETE FROM " + table + " AS t USING " + stage + " AS s WHERE " + keysMatch(keyCols)}
+	})
+}
+
+// keysMatch returns the condition matching the key columns of the target t and the staged rows s.
+func keysMatch(keyCols []string) string {
+	conditions := make([]string, len(keyCols))
+	for i, key := range keyCols {
+		conditions[i] = "t." + quoteIdentifier(key) + " = s." + quoteIdentifier(key)
+	}
+	return strings.Join(conditions, " AND ")
+}
+
+// firstStagedRows returns a subquery of the staged rows, which keeps the first row of multiple rows with the
+// same keys, in the order of appending them. It keeps all rows with NULL keys, as these do not match any row.
+func firstStagedRows(stage string, keyCols []string) string {
+	keys := make([]string, len(keyCols))
+	nullKeys := make([]string, len(keyCols))
+	for i, key := range keyCols {
+		keys[i] = quoteIdentifier(key)
+		nullKeys[i] = keys[i] + " IS NULL"
+	}
+	return "(SELECT * FROM " + stage + " QUALIFY row_number() OVER (PARTITION BY " + strings.Join(keys, ", ") +
+		" ORDER BY rowid) = 1 OR " + strings.Join(nullKeys, " OR ") + ")"
+}
+
+// bulkMutate stages rows in a temporary table with the columns of the target table, and executes the statements
+// returned by mutate. It executes them in a transaction, unless the connection has an active transaction.
+// It drops the temporary table before returning.
+func (c *Conn) bulkMutate(ctx context.Context, target string, rows any, keyCols []string,
+	mutate func(table, stage string, columns []string) []string,
+) (err error) {
+	if target == "" {
+		return getError(errAPI, errEmptyName)
+	}
+	if len(keyCols) == 0 {
+		return getError(errAPI, invalidInputError("no key columns", "at least one key column"))
+	}
+
+	table := quoteIdentifier(target)
+	driverRows, err := c.QueryContext(ctx, "SELECT * FROM "+table+" LIMIT 0", nil)
+	if err != nil {
+		return err
+	}
+	columns := driverRows.Columns()
+	if err = driverRows.Close(); err != nil {
+		return err
+	}
+	for _, key := range keyCols {
+		if !slices.Contains(columns, key) {
+			return getError(errAPI, invalidInputError(strconv.Quote(key), "a column of "+target))
+		}
+	}
+
+	// Drop the temporary table after ending the transaction, as rolling it back keeps the table.
+	// In an aborted transaction of the caller, dropping it fails, so the caller's rollback keeps it.
+	stageName := "duckdb_bulk_" + strconv.FormatUint(bulkStagingTables.Add(1), 10)
+	stage := "temp.main." + quoteIdentifier(stageName)
+	defer func() {
+		_, errDrop := c.ExecContext(context.Background(), "DROP TABLE IF EXISTS "+stage, nil)
+		if err == nil {
+			err = errDrop
+		}
+	}()
+
+	if !c.tx {
+		var tx driver.Tx
+		if tx, err = c.BeginTx(ctx, driver.TxOptions{}); err != nil {
+			return err
+		}
+		defer func() {
+			if err != nil {
+				err = errors.Join(err, tx.Rollback())
+				return
+			}
+			err = tx.Commit()
+		}()
+	}
+
+	if _, err = c.ExecContext(ctx, "CREATE TEMP TABLE "+quoteIdentifier(stageName)+" AS SELECT * FROM "+table+" LIMIT 0", nil); err != nil {
+		return err
+	}
+
+	a, err := NewAppenderFromConn(c, "", stageName)
+	if err != nil {
+		return err
+	}
+	err = a.AppendRows(rows)
+	if errClose := a.Close(); err == nil {
+		err = errClose
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, statement := range mutate(table, stage, columns) {
+		if _, err = c.ExecContext(ctx, statement, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulkMutations(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	createTable(db, t, `CREATE TABLE users (id INTEGER, name VARCHAR, active BOOLEAN)`)
+	createTable(db, t, `CREATE TABLE tags (id INTEGER PRIMARY KEY, name VARCHAR)`)
+	_, err := db.Exec(`INSERT INTO users VALUES (1, 'a', true), (2, 'b', true), (3, 'c', false)`)
+	require.NoError(t, err)
+
+	type user struct {
+		ID     int32  `db:"id"`
+		Name   string `db:"name"`
+		Active bool   `db:"active"`
+	}
+
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	require.NoError(t, err)
+
+	users := func() []user {
+		rows, err := db.Query(`SELECT * FROM users ORDER BY id`)
+		require.NoError(t, err)
+		res, err := ScanAll[user](rows)
+		require.NoError(t, err)
+		return res
+	}
+	// Temporary tables are only visible to their connection.
+	tables := func(c *Conn) int64 {
+		var n int64
+		err := c.queryValues(ctx, `SELECT count(*) FROM duckdb_tables() WHERE temporary`, nil, 1, func(values []driver.Value) {
+			n = values[0].(int64)
+		})
+		require.NoError(t, err)
+		return n
+	}
+
+	err = conn.Raw(func(driverConn any) error {
+		c := driverConn.(*Conn)
+
+		// Update the matching rows, and insert the others.
+		err := c.BulkMerge(ctx, "users", []user{{ID: 2, Name: "B"}, {ID: 4, Name: "d", Active: true}}, []string{"id"})
+		require.NoError(t, err)
+		require.Equal(t, []user{{1, "a", true}, {2, "B", false}, {3, "c", false}, {4, "d", true}}, users())
+
+		// Of multiple rows with the same keys, only the first one is inserted or updates a row.
+		type tag struct {
+			ID   int32  `db:"id"`
+			Name string `db:"name"`
+		}
+		tags := func() []tag {
+			rows, err := db.Query(`SELECT * FROM tags ORDER BY id`)
+			require.NoError(t, err)
+			res, err := ScanAll[tag](rows)
+			require.NoError(t, err)
+			return res
+		}
+		require.NoError(t, c.BulkMerge(ctx, "tags", []tag{{1, "a"}, {1, "b"}, {2, "c"}}, []string{"id"}))
+		require.Equal(t, []tag{{1, "a"}, {2, "c"}}, tags())
+		require.NoError(t, c.BulkMerge(ctx, "tags", []tag{{2, "d"}, {2, "e"}, {3, "f"}, {3, "g"}}, []string{"id"}))
+		require.Equal(t, []tag{{1, "a"}, {2, "d"}, {3, "f"}}, tags())
+
+		// Multiple key columns must all match.
+		err = c.BulkDelete(ctx, "users", []*user{{ID: 1, Name: "a"}, {ID: 3, Name: "x"}, {ID: 4, Name: "d"}}, []string{"id", "name"})
+		require.NoError(t, err)
+		require.Equal(t, []user{{2, "B", false}, {3, "c", false}}, users())
+		require.Zero(t, tables(c))
+
+		// A failed mutation rolls back, and drops the staging table.
+		type other struct {
+			ID int32 `db:"id"`
+		}
+		err = c.BulkMerge(ctx, "users", []other{{ID: 2}}, []string{"id"})
+		require.ErrorContains(t, err, errAppenderAppendRow.Error())
+		require.Equal(t, []user{{2, "B", false}, {3, "c", false}}, users())
+		require.Zero(t, tables(c))
+
+		testError(t, c.BulkMerge(ctx, "", []user{}, []string{"id"}), errAPI.Error(), errEmptyName.Error())
+		testError(t, c.BulkMerge(ctx, "users", []user{}, nil), errAPI.Error(), invalidInputErrMsg)
+		testError(t, c.BulkDelete(ctx, "users", []user{}, []string{"missing"}), errAPI.Error(), invalidInputErrMsg, "missing")
+		require.ErrorContains(t, c.BulkDelete(ctx, "missing", []user{}, []string{"id"}), "missing")
+		return nil
+	})
+	require.NoError(t, err)
+
+	// In a transaction, the mutations roll back with it.
+	tx, err := conn.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	err = conn.Raw(func(driverConn any) error {
+		return driverConn.(*Conn).BulkDelete(ctx, "users", []user{{ID: 2}}, []string{"id"})
+	})
+	require.NoError(t, err)
+	var count int
+	require.NoError(t, tx.QueryRow(`SELECT count(*) FROM users`).Scan(&count))
+	require.Equal(t, 1, count)
+	require.NoError(t, tx.Rollback())
+	require.Len(t, users(), 2)
+
+	require.NoError(t, conn.Close())
+	require.NoError(t, db.Close())
+}
@@ -11,21 +11,83 @@ import (
 	"database/sql/driver"
 	"errors"
 	"math/big"
+	"reflect"
+	"time"
 	"unsafe"
 )
 
 // Conn holds a connection to a DuckDB database.
 // It implements the driver.Conn interface.
+// Use (*sql.Conn).Raw to access the driver connection of a *sql.Conn, e.g., to call its methods,
+// or to access its prepared statements and results.
 type Conn struct {
 	duckdbCon C.duckdb_connection
 	closed    bool
 	tx        bool
+	// txAppenders are the appenders created in the active transaction.
+	txAppenders []*Appender
+	// location is the location of scanned TIMESTAMPTZ values, or nil for UTC.
+	location *time.Location
+	// rawText is true, if VARCHAR values reference DuckDB's memory.
+	rawText bool
+	// rawJSON is true, if JSON values return json.RawMessage values.
+	rawJSON bool
+	// streamResults is true, if queries stream their results.
+	streamResults bool
+	// queryTimeout is the timeout of each query, or zero.
+	queryTimeout time.Duration
 }
 
 // CheckNamedValue implements the driver.NamedValueChecker interface.
 func (c *Conn) CheckNamedValue(nv *driver.NamedValue) error {
-	switch nv.Value.(type) {
-	case *big.Int, Interval:
+	switch v := nv.Value.(type) {
+	case *big.Int, Interval, Decimal:
+		return nil
+	case NullInterval:
+		nv.Value = nil
+		if v.Valid {
+			nv.Value = v.Interval
+		}
+		return nil
+	case NullDecimal:
+		nv.Value = nil
+		if v.Valid {
+			nv.Value = v.Decimal
+		}
+		return nil
+	case *Interval, *NullInterval, *NullDecimal:
+		// Bind the values of the pointers, instead of their driver.Valuer representations.
+		if reflect.ValueOf(v).IsNil() {
+			nv.Value = nil
+			return nil
+		}
+		nv.Value = reflect.ValueOf(v).Elem().Interface()
+		return c.CheckNamedValue(nv)
+	case driver.Valuer, []byte, nil:
+		return driver.ErrSkip
+	}
+
+	// Bind slices, arrays, structs, and maps as nested values.
+	// The default conversion passes other byte slices as BLOB values.
+	t := reflect.TypeOf(nv.Value)
+	switch {
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+		return driver.ErrSkip
+	case t.Kind() == reflect.Slice, t.Kind() == reflect.Array, t.Kind() == reflect.Struct, t.Kind() == reflect.Map:
+		return nil
+	case t.Kind() == reflect.Pointer:
+		// Bind the value of a pointer to a type of the driver, e.g., a *Decimal or a *[]int32.
+		// The default conversion dereferences all other pointers, and converts nil pointers to NULL.
+		v := reflect.ValueOf(nv.Value)
+		if v.IsNil() {
+			return driver.ErrSkip
+		}
+		elem := *nv
+		elem.Value = v.Elem().Interface()
+		if err := c.CheckNamedValue(&elem); err != nil {
+			return err
+		}
+		*nv = elem
 		return nil
 	}
 	return driver.ErrSkip
@@ -75,6 +137,80 @@ func (c *Conn) QueryContext(ctx context.Context, query string, args []driver.Nam
 	return r, nil
 }
 
+// SetSetting runs SET on this connection to set the DuckDB setting name to value, e.g., SetSetting("threads", "1").
+// Settings with a local scope only affect this connection, while global settings, e.g., threads,
+// affect all connections to the database.
+func (c *Conn) SetSetting(name, value string) error {
+	_, err := c.ExecContext(context.Background(), "SET "+quoteIdentifier(name)+" = "+quoteLiteral(value), nil)
+	return err
+}
+
+// ResetSetting runs RESET on this connection to restore the default value of the DuckDB setting name.
+func (c *Conn) ResetSetting(name string) error {
+	_, err := c.ExecContext(context.Background(), "RESET "+quoteIdentifier(name), nil)
+	return err
+}
+
+// setTimeZone sets DuckDB's TimeZone setting to the location's name, if the ICU extension is available.
+// Without ICU, DuckDB converts TIMESTAMPTZ values in SQL in UTC.
+func (c *Conn) setTimeZone(location *time.Location) error {
+	rows, err := c.QueryContext(context.Background(), `SELECT COUNT(*) FROM duckdb_extensions()
+		WHERE extension_name = 'icu' AND (loaded OR installed)`, nil)
+	if err != nil {
+		return err
+	}
+	values := make([]driver.Value, 1)
+	err = rows.Next(values)
+	closeErr := rows.Close()
+	if err != nil {
+		return err
+	}
+	if closeErr != nil {
+		return closeErr
+	}
+	if values[0].(int64) == 0 {
+		return nil
+	}
+	return c.SetSetting("TimeZone", location.String())
+}
+
+// queryContext returns a context applying the connection's query timeout in addition to the deadline of ctx.
+func (c *Conn) queryContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.queryTimeout > 0 {
+		return context.WithTimeout(ctx, c.queryTimeout)
+	}
+	return ctx, func() {}
+}
+
+// interruptible runs f, which executes a query on the connection, and interrupts the query once ctx is done.
+func (c *Conn) interruptible(ctx context.Context, f func()) {
+	if ctx.Done() == nil {
+		f()
+		return
+	}
+
+	mainDoneCh := make(chan struct{})
+	bgDoneCh := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			C.duckdb_interrupt(c.duckdbCon)
+			close(bgDoneCh)
+			return
+		case <-mainDoneCh:
+			close(bgDoneCh)
+			return
+		}
+	}()
+
+	f()
+	close(mainDoneCh)
+	// also wait for background goroutine to finish
+	// sometimes the bg goroutine is not scheduled immediately and by that time if another query is running on this connection
+	// it can cancel that query so need to wait for it to finish as well
+	<-bgDoneCh
+}
+
 // PrepareContext returns a prepared statement, bound to this connection.
 // It implements the driver.ConnPrepareContext interface.
 func (c *Conn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
@@ -130,6 +266,21 @@ func (c *Conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, e
 	return &tx{c}, nil
 }
 
+// Ping verifies that the connection is usable by executing SELECT 1 on it.
+// It returns an error wrapping driver.ErrBadConn, if the connection is closed,
+// so that database/sql discards the connection from its pool.
+// It implements the driver.Pinger interface.
+func (c *Conn) Ping(ctx context.Context) error {
+	if c.closed {
+		return errors.Join(driver.ErrBadConn, errClosedCon)
+	}
+	rows, err := c.QueryContext(ctx, "SELECT 1", nil)
+	if err != nil {
+		return err
+	}
+	return rows.Close()
+}
+
 // Close closes the connection to the database.
 // It implements the driver.Conn interface.
 func (c *Conn) Close() error {
@@ -202,3 +353,43 @@ func (c *Conn) prepareStmts(ctx context.Context, query string) (*Stmt, error) {
 	}
 	return c.prepareExtractedStmt(stmts, count-1)
 }
+
+// ExecScript executes a script of semicolon-separated statements in sequence, e.g., a migration file.
+// ExecScript returns the total number of rows affected by the statements.
+// Empty statements and comments are skipped. It stops at the first failing statement, and returns its error, which contains the statement's index.
+func (c *Conn) ExecScript(ctx context.Context, script string) (int64, error) {
+	if c.closed {
+		return 0, errClosedCon
+	}
+
+	stmts, count, err := c.extractStmts(script)
+	if errors.Is(err, errEmptyQuery) {
+		// Scripts without statements, e.g., containing only comments, do nothing.
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer C.duckdb_destroy_extracted(&stmts)
+
+	var rowsAffected int64
+	for i := C.idx_t(0); i < count; i++ {
+		prepared, err := c.prepareExtractedStmt(stmts, i)
+		if err != nil {
+			return rowsAffected, addIndexToError(err, int(i))
+		}
+
+		res, execErr := prepared.ExecContext(ctx, nil)
+		closeErr := prepared.Close()
+		if execErr != nil {
+			return rowsAffected, addIndexToError(execErr, int(i))
+		}
+		if closeErr != nil {
+			return rowsAffected, closeErr
+		}
+
+		ra, _ := res.RowsAffected()
+		rowsAffected += ra
+	}
+	return rowsAffected, nil
+}
@@ -53,7 +53,7 @@ func (chunk *DataChunk) GetValue(colIdx int, rowIdx int) (any, error) {
 
 // SetValue writes a single value to a column in a data chunk.
 // Note that this requires casting the type for each invocation.
-// NOTE: Custom ENUM types must be passed as string.
+// NOTE: Custom ENUM types must be passed as string or as their integer dictionary index.
 func (chunk *DataChunk) SetValue(colIdx int, rowIdx int, val any) error {
 	if colIdx >= len(chunk.columns) {
 		return getError(errAPI, columnCountError(colIdx, len(chunk.columns)))
@@ -66,7 +66,7 @@ func (chunk *DataChunk) SetValue(colIdx int, rowIdx int, val any) error {
 // SetChunkValue writes a single value to a column in a data chunk.
 // The difference with `chunk.SetValue` is that `SetChunkValue` does not
 // require casting the value to `any` (implicitly).
-// NOTE: Custom ENUM types must be passed as string.
+// NOTE: Custom ENUM types must be passed as string or as their integer dictionary index.
 func SetChunkValue[T any](chunk DataChunk, colIdx int, rowIdx int, val T) error {
 	if colIdx >= len(chunk.columns) {
 		return getError(errAPI, columnCountError(colIdx, len(chunk.columns)))
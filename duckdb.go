@@ -15,7 +15,10 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"net/url"
+	"slices"
 	"strings"
+	"sync"
+	"time"
 	"unsafe"
 )
 
@@ -39,55 +42,243 @@ func (Driver) OpenConnector(dsn string) (driver.Connector, error) {
 	})
 }
 
+const (
+	accessModeOption   = "access_mode"
+	accessModeReadOnly = "READ_ONLY"
+)
+
 // NewConnector opens a new Connector for a DuckDB database.
 // The user must close the Connector, if it is not passed to the sql.OpenDB function.
 // Otherwise, sql.DB closes the Connector when calling sql.DB.Close().
 func NewConnector(dsn string, connInitFn func(execer driver.ExecerContext) error) (*Connector, error) {
+	return NewConnectorWithConfig(dsn, ConnectorConfig{ConnInitFn: connInitFn})
+}
+
+// ConnectorConfig contains the options to configure a Connector.
+type ConnectorConfig struct {
+	// Settings contains DuckDB configuration options, e.g., memory_limit, threads, temp_directory, or access_mode.
+	// The Connector sets them when opening the database, so they apply to every connection.
+	// They take precedence over the configuration options of the DSN.
+	// The Connector fails to open an already open named in-memory database, if they differ from its settings.
+	Settings map[string]string
+	// ReadOnly opens the database in read-only mode, i.e., it sets the access_mode to READ_ONLY.
+	// It takes precedence over an access_mode in the Settings and the DSN.
+	// Multiple processes can open the same database file in read-only mode.
+	// The Connector fails to open an already open named in-memory database, if it was not opened in read-only mode.
+	ReadOnly bool
+	// TimeZone is the IANA name of the connections' time zone, e.g., America/New_York.
+	// Scanning a TIMESTAMPTZ value, also one nested in a LIST, STRUCT, or MAP, then returns a time.Time
+	// in this location, instead of in UTC.
+	// If DuckDB's ICU extension is available, each connection also sets DuckDB's TimeZone setting,
+	// which affects converting TIMESTAMPTZ values in SQL, e.g., casts to VARCHAR or TIMESTAMP.
+	TimeZone string
+	// RawText makes the connections return VARCHAR columns as []byte values, which reference DuckDB's memory,
+	// instead of copying each value into a string. Scanning into a *sql.RawBytes or a *TextBuffer then does not
+	// allocate, but the sql.RawBytes is only valid until the next call to Next, Scan, or Close.
+	// Scanning into a *string or a *[]byte still copies the value, while scanning into an *any returns a []byte.
+	// Other Scanners must copy the []byte to retain it.
+	RawText bool
+	// RawJSON makes the connections return JSON values as json.RawMessage values, instead of unmarshalling them.
+	// This keeps the JSON text, e.g., the precision of numbers and the order of object keys, and applies to nested
+	// JSON values, too.
+	RawJSON bool
+	// QueryTimeout limits the execution time of each query on the connections, if it is positive.
+	// A query exceeding it is interrupted, and returns context.DeadlineExceeded.
+	// It applies in addition to the deadline of the query's context, so that the earlier deadline wins.
+	QueryTimeout time.Duration
+	// StreamResults makes the queries on the connections stream their results, instead of materializing them.
+	// DuckDB then produces the result chunks while the rows are fetched, which bounds the memory of large results.
+	// A streamed result is invalid once the connection executes another statement, and closing the rows
+	// stops the query. The query's context and QueryTimeout also apply to fetching the rows.
+	StreamResults bool
+	// InitStatements are executed in order on each new connection, e.g., to load extensions or to set
+	// connection-local options. Connect fails, if any of them fails.
+	// They run before ConnInitFn.
+	InitStatements []string
+	// ConnInitFn is invoked for each new connection, see NewConnector.
+	ConnInitFn func(execer driver.ExecerContext) error
+}
+
+// NewConnectorWithConfig opens a new Connector for a DuckDB database, and configures it with config.
+// It returns an error, if any of the config.Settings is an unknown option, or has an invalid value.
+// The user must close the Connector, if it is not passed to the sql.OpenDB function.
+// Otherwise, sql.DB closes the Connector when calling sql.DB.Close().
+func NewConnectorWithConfig(dsn string, config ConnectorConfig) (*Connector, error) {
 	var db C.duckdb_database
 
-	parsedDSN, err := url.Parse(dsn)
+	options, err := getDSNOptions(dsn)
 	if err != nil {
 		return nil, getError(errParseDSN, err)
 	}
 
-	config, err := prepareConfig(parsedDSN)
+	var location *time.Location
+	if config.TimeZone != "" {
+		if location, err = time.LoadLocation(config.TimeZone); err != nil {
+			return nil, getError(errTimeZone, err)
+		}
+	}
+
+	settings := config.Settings
+	if config.ReadOnly {
+		settings = make(map[string]string, len(config.Settings)+1)
+		for k, v := range config.Settings {
+			settings[k] = v
+		}
+		settings[accessModeOption] = accessModeReadOnly
+	}
+
+	dbOptions := configOptions(options, settings)
+	duckdbConfig, err := prepareConfig(dbOptions)
 	if err != nil {
 		return nil, err
 	}
-	defer C.duckdb_destroy_config(&config)
+	defer C.duckdb_destroy_config(&duckdbConfig)
+
+	path := getConnString(dsn)
+	name := memoryDatabaseName(path)
+	c := &Connector{
+		connInitFn:     config.ConnInitFn,
+		initStatements: slices.Clone(config.InitStatements),
+		memoryName:     name,
+		location:       location,
+		rawText:        config.RawText,
+		rawJSON:        config.RawJSON,
+		streamResults:  config.StreamResults,
+		queryTimeout:   config.QueryTimeout,
+	}
+
+	if name != "" {
+		// Reuse the named in-memory database, if another Connector already opened it.
+		memoryDatabasesLock.Lock()
+		defer memoryDatabasesLock.Unlock()
+		if shared, ok := memoryDatabases[name]; ok {
+			if err = shared.checkOptions(dbOptions); err != nil {
+				return nil, getError(errConnect, err)
+			}
+			shared.refs++
+			c.db = shared.db
+			return c, nil
+		}
+		path = memoryDatabasePrefix
+	}
 
-	connStr := C.CString(getConnString(dsn))
+	connStr := C.CString(path)
 	defer C.duckdb_free(unsafe.Pointer(connStr))
 
 	var outError *C.char
 	defer C.duckdb_free(unsafe.Pointer(outError))
 
-	if state := C.duckdb_open_ext(connStr, &db, config, &outError); state == C.DuckDBError {
+	if state := C.duckdb_open_ext(connStr, &db, duckdbConfig, &outError); state == C.DuckDBError {
 		return nil, getError(errConnect, duckdbError(outError))
 	}
+	if err = registerMapValueFunction(db); err != nil {
+		C.duckdb_close(&db)
+		return nil, getError(errConnect, err)
+	}
+
+	if name != "" {
+		memoryDatabases[name] = &memoryDatabase{db: db, refs: 1, options: dbOptions}
+	}
 
-	return &Connector{
-		db:         db,
-		connInitFn: connInitFn,
-	}, nil
+	c.db = db
+	return c, nil
 }
 
 type Connector struct {
 	db         C.duckdb_database
 	connInitFn func(execer driver.ExecerContext) error
+	// initStatements are executed on each new connection.
+	initStatements []string
+	// memoryName is the name of a named in-memory database, or empty.
+	memoryName string
+	// location is the location of scanned TIMESTAMPTZ values, or nil for UTC.
+	location *time.Location
+	// rawText is true, if VARCHAR values reference DuckDB's memory.
+	rawText bool
+	// rawJSON is true, if JSON values return json.RawMessage values.
+	rawJSON bool
+	// streamResults is true, if queries stream their results.
+	streamResults bool
+	// queryTimeout is the timeout of each query, or zero.
+	queryTimeout time.Duration
+}
+
+// memoryDatabasePrefix is the DSN prefix of in-memory databases.
+// A name following the prefix, e.g., :memory:name, opens a named in-memory database.
+const memoryDatabasePrefix = ":memory:"
+
+// memoryDatabase is a named in-memory database, which is shared by all Connectors opening its name.
+type memoryDatabase struct {
+	db C.duckdb_database
+	// refs is the number of open Connectors using the database.
+	refs int
+	// options are the configuration options of the Connector that opened the database.
+	options map[string]string
+}
+
+// checkOptions returns an error, if any of the options differs from the options the database was opened with.
+// The options of the open database apply, so other Connectors can only open it with matching options.
+func (shared *memoryDatabase) checkOptions(options map[string]string) error {
+	for k, v := range options {
+		if opened, ok := shared.options[k]; !ok || !strings.EqualFold(opened, v) {
+			return fmt.Errorf("%w: %s=%s", errMemoryConfig, k, v)
+		}
+	}
+	return nil
+}
+
+var (
+	memoryDatabasesLock sync.Mutex
+	memoryDatabases     = map[string]*memoryDatabase{}
+)
+
+// memoryDatabaseName returns the name of a named in-memory database path, or an empty string.
+func memoryDatabaseName(path string) string {
+	if !strings.HasPrefix(path, memoryDatabasePrefix) {
+		return ""
+	}
+	return path[len(memoryDatabasePrefix):]
+}
+
+// releaseMemoryDatabase closes the named in-memory database, once its last Connector closes.
+func releaseMemoryDatabase(name string) {
+	memoryDatabasesLock.Lock()
+	defer memoryDatabasesLock.Unlock()
+
+	shared := memoryDatabases[name]
+	shared.refs--
+	if shared.refs == 0 {
+		C.duckdb_close(&shared.db)
+		delete(memoryDatabases, name)
+	}
 }
 
 func (*Connector) Driver() driver.Driver {
 	return Driver{}
 }
 
-func (c *Connector) Connect(context.Context) (driver.Conn, error) {
+func (c *Connector) Connect(ctx context.Context) (driver.Conn, error) {
 	var duckdbCon C.duckdb_connection
 	if state := C.duckdb_connect(c.db, &duckdbCon); state == C.DuckDBError {
 		return nil, getError(errConnect, nil)
 	}
 
-	con := &Conn{duckdbCon: duckdbCon}
+	con := &Conn{duckdbCon: duckdbCon, location: c.location, rawText: c.rawText, rawJSON: c.rawJSON,
+		streamResults: c.streamResults, queryTimeout: c.queryTimeout}
+
+	if c.location != nil {
+		if err := con.setTimeZone(c.location); err != nil {
+			con.Close()
+			return nil, err
+		}
+	}
+
+	for i, stmt := range c.initStatements {
+		if _, err := con.ExecContext(ctx, stmt, nil); err != nil {
+			con.Close()
+			return nil, getError(errInitStmt, addIndexToError(err, i))
+		}
+	}
 
 	if c.connInitFn != nil {
 		if err := c.connInitFn(con); err != nil {
@@ -99,11 +290,52 @@ func (c *Connector) Connect(context.Context) (driver.Conn, error) {
 }
 
 func (c *Connector) Close() error {
-	C.duckdb_close(&c.db)
+	if c.db == nil {
+		return nil
+	}
+	if c.memoryName != "" {
+		releaseMemoryDatabase(c.memoryName)
+	} else {
+		C.duckdb_close(&c.db)
+	}
 	c.db = nil
 	return nil
 }
 
+// Version returns the version and the source id of the linked DuckDB library, e.g., "v1.1.3" and "19864453f7".
+// The C API does not expose the source id, so Version queries pragma_version() of an in-memory database.
+// If opening the database fails, e.g., due to insufficient memory, then the source id is empty,
+// and the next call to Version tries again.
+func Version() (string, string) {
+	return C.GoString(C.duckdb_library_version()), sourceID()
+}
+
+var (
+	// sourceIDLock protects sourceIDValue, which is empty until querying the source id succeeds.
+	sourceIDLock  sync.Mutex
+	sourceIDValue string
+)
+
+func sourceID() string {
+	sourceIDLock.Lock()
+	defer sourceIDLock.Unlock()
+	if sourceIDValue != "" {
+		return sourceIDValue
+	}
+
+	c, err := NewConnector("", nil)
+	if err != nil {
+		return ""
+	}
+	db := sql.OpenDB(c)
+	defer db.Close()
+
+	if err = db.QueryRow(`SELECT source_id FROM pragma_version()`).Scan(&sourceIDValue); err != nil {
+		sourceIDValue = ""
+	}
+	return sourceIDValue
+}
+
 func getConnString(dsn string) string {
 	idx := strings.Index(dsn, "?")
 	if idx < 0 {
@@ -112,7 +344,32 @@ func getConnString(dsn string) string {
 	return dsn[0:idx]
 }
 
-func prepareConfig(parsedDSN *url.URL) (C.duckdb_config, error) {
+// getDSNOptions parses the configuration options following the '?' of the DSN.
+// It does not parse the DSN as a URL, as paths like :memory: are not valid URLs.
+func getDSNOptions(dsn string) (url.Values, error) {
+	idx := strings.Index(dsn, "?")
+	if idx < 0 {
+		return url.Values{}, nil
+	}
+	return url.ParseQuery(dsn[idx+1:])
+}
+
+// configOptions merges the configuration options of the DSN and the settings.
+// The settings take precedence over the options of the DSN.
+func configOptions(options url.Values, settings map[string]string) map[string]string {
+	merged := make(map[string]string, len(options)+len(settings))
+	for k, v := range options {
+		if len(v) != 0 {
+			merged[strings.ToLower(k)] = v[0]
+		}
+	}
+	for k, v := range settings {
+		merged[strings.ToLower(k)] = v
+	}
+	return merged
+}
+
+func prepareConfig(options map[string]string) (C.duckdb_config, error) {
 	var config C.duckdb_config
 	if state := C.duckdb_create_config(&config); state == C.DuckDBError {
 		C.duckdb_destroy_config(&config)
@@ -123,16 +380,8 @@ func prepareConfig(parsedDSN *url.URL) (C.duckdb_config, error) {
 		return nil, err
 	}
 
-	// Early-out, if the DSN does not contain configuration options.
-	if len(parsedDSN.RawQuery) == 0 {
-		return config, nil
-	}
-
-	for k, v := range parsedDSN.Query() {
-		if len(v) == 0 {
-			continue
-		}
-		if err := setConfigOption(config, k, v[0]); err != nil {
+	for k, v := range options {
+		if err := setConfigOption(config, k, v); err != nil {
 			return nil, err
 		}
 	}
@@ -3,6 +3,7 @@
 package duckdb
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
@@ -21,3 +22,24 @@ func TestOpenSQLite(t *testing.T) {
 	require.Equal(t, "Gopher", species)
 	require.NoError(t, db.Close())
 }
+
+func TestAttachSQLite(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	if _, err := db.Exec(`INSTALL sqlite; LOAD sqlite`); err != nil {
+		t.Skip("the sqlite extension is not available: ", err)
+	}
+
+	conn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	err = conn.Raw(func(driverConn any) error {
+		return driverConn.(*Conn).Attach("sqlite:testdata/pets.sqlite", "pets_db", true)
+	})
+	require.NoError(t, err)
+
+	var species string
+	require.NoError(t, db.QueryRow("SELECT species FROM pets_db.pets WHERE id=1").Scan(&species))
+	require.Equal(t, "Gopher", species)
+	require.NoError(t, conn.Close())
+	require.NoError(t, db.Close())
+}
@@ -9,6 +9,7 @@ import (
 	"log"
 	"math/big"
 	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 	"time"
@@ -41,6 +42,275 @@ func TestOpen(t *testing.T) {
 	})
 }
 
+func TestVersion(t *testing.T) {
+	version, sourceID := Version()
+	require.NotEmpty(t, version)
+	require.NotEmpty(t, sourceID)
+
+	// Both match the version of the database.
+	db := openDB(t)
+	var expectedVersion, expectedSourceID string
+	require.NoError(t, db.QueryRow(`SELECT library_version, source_id FROM pragma_version()`).Scan(&expectedVersion, &expectedSourceID))
+	require.Equal(t, expectedVersion, version)
+	require.Equal(t, expectedSourceID, sourceID)
+	require.NoError(t, db.Close())
+}
+
+func TestConnectorWithConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("settings", func(t *testing.T) {
+		tempDir := t.TempDir()
+		connector, err := NewConnectorWithConfig("?threads=2", ConnectorConfig{
+			Settings: map[string]string{
+				"memory_limit":   "1GB",
+				"threads":        "3",
+				"temp_directory": tempDir,
+			},
+		})
+		require.NoError(t, err)
+
+		db := sql.OpenDB(connector)
+		db.SetMaxOpenConns(2)
+
+		// Every connection uses the settings.
+		var conns []*sql.Conn
+		for i := 0; i < 2; i++ {
+			conn, err := db.Conn(context.Background())
+			require.NoError(t, err)
+			conns = append(conns, conn)
+
+			var (
+				memoryLimit string
+				threads     int64
+				dir         string
+			)
+			row := conn.QueryRowContext(context.Background(),
+				"SELECT current_setting('memory_limit'), current_setting('threads'), current_setting('temp_directory')")
+			require.NoError(t, row.Scan(&memoryLimit, &threads, &dir))
+			require.Equal(t, "953.6 MiB", memoryLimit)
+			require.Equal(t, int64(3), threads)
+			require.Equal(t, tempDir, dir)
+		}
+		for _, conn := range conns {
+			require.NoError(t, conn.Close())
+		}
+		require.NoError(t, db.Close())
+	})
+
+	t.Run("connection init function", func(t *testing.T) {
+		inits := 0
+		connector, err := NewConnectorWithConfig("", ConnectorConfig{
+			ConnInitFn: func(execer driver.ExecerContext) error {
+				inits++
+				return nil
+			},
+		})
+		require.NoError(t, err)
+
+		db := sql.OpenDB(connector)
+		require.NoError(t, db.Ping())
+		require.Equal(t, 1, inits)
+		require.NoError(t, db.Close())
+	})
+
+	t.Run("invalid settings", func(t *testing.T) {
+		_, err := NewConnectorWithConfig("", ConnectorConfig{Settings: map[string]string{"unknown_setting": "1"}})
+		testError(t, err, errConnect.Error(), "unknown_setting")
+
+		_, err = NewConnectorWithConfig("", ConnectorConfig{Settings: map[string]string{"threads": "many"}})
+		testError(t, err, errSetConfig.Error(), "threads=many")
+	})
+}
+
+func TestConnectorReadOnly(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "read_only.db")
+
+	db, err := sql.Open("duckdb", path)
+	require.NoError(t, err)
+	_, err = db.Exec(`CREATE TABLE test AS SELECT 42 AS v`)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	// Open the database file twice in read-only mode, and with additional settings.
+	var dbs []*sql.DB
+	for i := 0; i < 2; i++ {
+		connector, err := NewConnectorWithConfig(path+"?access_mode=read_write", ConnectorConfig{
+			Settings: map[string]string{"access_mode": "READ_WRITE", "threads": "2"},
+			ReadOnly: true,
+		})
+		require.NoError(t, err)
+		dbs = append(dbs, sql.OpenDB(connector))
+	}
+
+	for _, db := range dbs {
+		var (
+			v          int
+			accessMode string
+			threads    int64
+		)
+		row := db.QueryRow(`SELECT v, current_setting('access_mode'), current_setting('threads') FROM test`)
+		require.NoError(t, row.Scan(&v, &accessMode, &threads))
+		require.Equal(t, 42, v)
+		require.Equal(t, "read_only", accessMode)
+		require.Equal(t, int64(2), threads)
+
+		_, err = db.Exec(`INSERT INTO test VALUES (43)`)
+		require.ErrorContains(t, err, "read-only mode")
+		_, err = db.Exec(`CREATE TABLE other (v INT)`)
+		require.ErrorContains(t, err, "read-only mode")
+	}
+
+	for _, db := range dbs {
+		require.NoError(t, db.Close())
+	}
+}
+
+func TestConnectorTimeZone(t *testing.T) {
+	t.Parallel()
+	connector, err := NewConnectorWithConfig("", ConnectorConfig{TimeZone: "America/New_York"})
+	require.NoError(t, err)
+	db := sql.OpenDB(connector)
+	location, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	// The instants before and after the DST transitions.
+	instants := []time.Time{
+		time.Date(2024, 3, 10, 6, 59, 59, 0, time.UTC), // 01:59:59 EST.
+		time.Date(2024, 3, 10, 7, 0, 0, 0, time.UTC),   // 03:00:00 EDT.
+		time.Date(2024, 11, 3, 5, 30, 0, 0, time.UTC),  // 01:30:00 EDT.
+		time.Date(2024, 11, 3, 6, 30, 0, 0, time.UTC),  // 01:30:00 EST.
+	}
+	zones := []string{"EST", "EDT", "EDT", "EST"}
+
+	_, err = db.Exec(`CREATE TABLE test (id INT, ts TIMESTAMPTZ)`)
+	require.NoError(t, err)
+
+	// Insert the instants as values in the location, and as values in UTC, via the appender.
+	for i, instant := range instants {
+		_, err = db.Exec(`INSERT INTO test VALUES (?, ?)`, i, instant.In(location))
+		require.NoError(t, err)
+	}
+	conn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, conn.Raw(func(driverConn any) error {
+		a, err := NewAppenderFromConn(driverConn.(driver.Conn), "", "test")
+		require.NoError(t, err)
+		for i, instant := range instants {
+			require.NoError(t, a.AppendRow(int32(i), instant))
+		}
+		return a.Close()
+	}))
+	require.NoError(t, conn.Close())
+
+	// Scanning preserves the instants, and returns them in the location.
+	res, err := db.Query(`SELECT id, ts FROM test ORDER BY rowid`)
+	require.NoError(t, err)
+	count := 0
+	for res.Next() {
+		var id int
+		var ts time.Time
+		require.NoError(t, res.Scan(&id, &ts))
+		require.True(t, instants[id].Equal(ts), ts.String())
+		require.Equal(t, location, ts.Location())
+		zone, _ := ts.Zone()
+		require.Equal(t, zones[id], zone)
+		count++
+	}
+	require.Equal(t, 2*len(instants), count)
+	require.NoError(t, res.Close())
+
+	// Nested TIMESTAMPTZ values are in the location, too.
+	var list List[time.Time]
+	require.NoError(t, db.QueryRow(`SELECT list(ts ORDER BY id) FROM test WHERE rowid < ?`, len(instants)).Scan(&list))
+	require.Len(t, list.Get(), len(instants))
+	for i, ts := range list.Get() {
+		require.True(t, instants[i].Equal(ts), ts.String())
+		require.Equal(t, location, ts.Location())
+		zone, _ := ts.Zone()
+		require.Equal(t, zones[i], zone)
+	}
+
+	// Other column types are not affected.
+	var ts time.Time
+	require.NoError(t, db.QueryRow(`SELECT TIMESTAMP '2024-03-10 02:30:00'`).Scan(&ts))
+	require.Equal(t, time.UTC, ts.Location())
+	require.NoError(t, db.Close())
+
+	// Without a time zone, TIMESTAMPTZ values are in UTC.
+	defaultDB := openDB(t)
+	require.NoError(t, defaultDB.QueryRow(`SELECT TIMESTAMPTZ '2024-03-10 07:00:00+00'`).Scan(&ts))
+	require.Equal(t, time.UTC, ts.Location())
+	require.NoError(t, defaultDB.Close())
+
+	_, err = NewConnectorWithConfig("", ConnectorConfig{TimeZone: "Mars/Olympus_Mons"})
+	testError(t, err, errTimeZone.Error(), "Mars/Olympus_Mons")
+}
+
+func TestNamedMemoryDatabase(t *testing.T) {
+	t.Parallel()
+
+	db, err := sql.Open("duckdb", ":memory:named_test?threads=2")
+	require.NoError(t, err)
+	db.SetMaxOpenConns(2)
+
+	// Both connections of the pool share the database.
+	conn1, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	conn2, err := db.Conn(context.Background())
+	require.NoError(t, err)
+
+	_, err = conn1.ExecContext(context.Background(), `CREATE TABLE test AS SELECT 42 AS v`)
+	require.NoError(t, err)
+	var v int
+	require.NoError(t, conn2.QueryRowContext(context.Background(), `SELECT v FROM test`).Scan(&v))
+	require.Equal(t, 42, v)
+	require.NoError(t, conn1.Close())
+	require.NoError(t, conn2.Close())
+
+	// Another sql.DB opening the same name shares the database, too.
+	other, err := sql.Open("duckdb", ":memory:named_test")
+	require.NoError(t, err)
+	require.NoError(t, other.QueryRow(`SELECT v FROM test`).Scan(&v))
+	require.Equal(t, 42, v)
+
+	// Opening the name with conflicting configuration options fails.
+	_, err = NewConnectorWithConfig(":memory:named_test?threads=4", ConnectorConfig{})
+	testError(t, err, errConnect.Error(), errMemoryConfig.Error(), "threads=4")
+	_, err = NewConnectorWithConfig(":memory:named_test", ConnectorConfig{Settings: map[string]string{"threads": "1"}})
+	testError(t, err, errConnect.Error(), errMemoryConfig.Error(), "threads=1")
+	_, err = NewConnectorWithConfig(":memory:named_test", ConnectorConfig{ReadOnly: true})
+	testError(t, err, errConnect.Error(), errMemoryConfig.Error(), accessModeOption)
+	c, err := NewConnectorWithConfig(":memory:named_test", ConnectorConfig{Settings: map[string]string{"THREADS": "2"}})
+	require.NoError(t, err)
+	require.NoError(t, c.Close())
+
+	// Other names and unnamed in-memory databases do not share the database.
+	for _, dsn := range []string{":memory:other_named_test", ":memory:", ""} {
+		unrelated, err := sql.Open("duckdb", dsn)
+		require.NoError(t, err)
+		_, err = unrelated.Exec(`SELECT v FROM test`)
+		require.ErrorContains(t, err, "Table with name test does not exist")
+		require.NoError(t, unrelated.Close())
+	}
+
+	// The database is kept alive until its last sql.DB closes.
+	require.NoError(t, db.Close())
+	require.NoError(t, other.QueryRow(`SELECT v FROM test`).Scan(&v))
+	require.NoError(t, other.Close())
+
+	db, err = sql.Open("duckdb", ":memory:named_test")
+	require.NoError(t, err)
+	_, err = db.Exec(`SELECT v FROM test`)
+	require.ErrorContains(t, err, "Table with name test does not exist")
+	require.NoError(t, db.Close())
+
+	// Named in-memory databases do not create any files.
+	_, err = os.Stat(":memory:named_test")
+	require.True(t, os.IsNotExist(err))
+}
+
 func TestConnectorBootQueries(t *testing.T) {
 	t.Run("readme example", func(t *testing.T) {
 		db, err := sql.Open("duckdb", "foo.db")
@@ -156,6 +426,75 @@ func TestConnPool(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestPing(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	require.NoError(t, db.PingContext(context.Background()))
+
+	conn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, conn.PingContext(context.Background()))
+
+	// Close the underlying driver connection.
+	err = conn.Raw(func(driverConn any) error {
+		c := driverConn.(*Conn)
+		require.NoError(t, c.Ping(context.Background()))
+		require.NoError(t, c.Close())
+		err := c.Ping(context.Background())
+		require.ErrorIs(t, err, driver.ErrBadConn)
+		require.ErrorIs(t, err, errClosedCon)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Error(t, conn.PingContext(context.Background()))
+	require.Error(t, conn.Close())
+
+	// The pool discards the bad connection.
+	require.NoError(t, db.PingContext(context.Background()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, db.PingContext(ctx), context.Canceled)
+	require.NoError(t, db.Close())
+}
+
+func TestRowsAffected(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+
+	res, err := db.Exec(`CREATE TABLE test (id INTEGER, v VARCHAR)`)
+	require.NoError(t, err)
+	ra, err := res.RowsAffected()
+	require.NoError(t, err)
+	require.Equal(t, int64(0), ra)
+
+	tests := []struct {
+		sql      string
+		affected int64
+	}{
+		{sql: `INSERT INTO test SELECT range, 'x' FROM range(10)`, affected: 10},
+		{sql: `UPDATE test SET v = 'y' WHERE id < 7`, affected: 7},
+		{sql: `UPDATE test SET v = 'z' WHERE id > 100`, affected: 0},
+		{sql: `DELETE FROM test WHERE id % 2 = 0`, affected: 5},
+		// Statements returning rows report no changed rows, not even with a RETURNING clause.
+		{sql: `INSERT INTO test VALUES (100, 'a') RETURNING id`, affected: 0},
+		{sql: `SELECT 42`, affected: 0},
+		{sql: `SELECT count(*) FROM test`, affected: 0},
+	}
+	for _, test := range tests {
+		res, err = db.Exec(test.sql)
+		require.NoError(t, err, test.sql)
+		ra, err = res.RowsAffected()
+		require.NoError(t, err)
+		require.Equal(t, test.affected, ra, test.sql)
+	}
+
+	// DuckDB does not return the row IDs of inserted rows.
+	_, err = res.LastInsertId()
+	testError(t, err, errAPI.Error(), errLastInsertIDNotSupported.Error())
+	require.NoError(t, db.Close())
+}
+
 func TestConnInit(t *testing.T) {
 	connector, err := NewConnector("", func(execer driver.ExecerContext) error {
 		return nil
@@ -498,9 +837,15 @@ func TestTypeNamesAndScanTypes(t *testing.T) {
 		// DUCKDB_TYPE_TIME_TZ
 		{
 			sql:      `SELECT '11:30:00+03'::TIMETZ AS col`,
-			value:    time.Date(1, time.January, 1, 8, 30, 0, 0, time.UTC),
+			value:    time.Date(1, time.January, 1, 11, 30, 0, 0, time.FixedZone("", 3*60*60)),
 			typeName: "TIMETZ",
 		},
+		// DUCKDB_TYPE_BIT
+		{
+			sql:      `SELECT '0101'::BIT AS col`,
+			value:    Bit("0101"),
+			typeName: "BIT",
+		},
 		// DUCKDB_TYPE_TIMESTAMP_TZ
 		{
 			sql:      `SELECT '1992-09-20 11:30:00+03'::TIMESTAMPTZ AS col`,
@@ -531,6 +876,61 @@ func TestTypeNamesAndScanTypes(t *testing.T) {
 	require.NoError(t, db.Close())
 }
 
+func TestScanTypes(t *testing.T) {
+	db := openDB(t)
+	_, err := db.Exec(`CREATE TYPE greeting AS ENUM ('hello', 'world', '!')`)
+	require.NoError(t, err)
+
+	// The scan type of each column is the Go type of its values.
+	for _, info := range getTypeInfos(t, false) {
+		r, err := db.Query(`SELECT ` + info.input + `::` + info.String())
+		require.NoError(t, err, info.String())
+		cols, err := r.ColumnTypes()
+		require.NoError(t, err)
+
+		require.True(t, r.Next())
+		var val any
+		require.NoError(t, r.Scan(&val))
+		require.Equal(t, reflect.TypeOf(val), cols[0].ScanType(), info.String())
+		require.NoError(t, r.Close())
+	}
+	require.NoError(t, db.Close())
+}
+
+func TestDatabaseTypeNames(t *testing.T) {
+	db := openDB(t)
+	_, err := db.Exec(`CREATE TYPE mood AS ENUM ('happy', 'it''s ok', 'sad')`)
+	require.NoError(t, err)
+
+	tests := []struct {
+		sql      string
+		typeName string
+	}{
+		{sql: `SELECT 1.5::DECIMAL(4, 1)`, typeName: `DECIMAL(4,1)`},
+		{sql: `SELECT 'happy'::mood`, typeName: `ENUM('happy', 'it''s ok', 'sad')`},
+		{sql: `SELECT ['happy'::mood]`, typeName: `ENUM('happy', 'it''s ok', 'sad')[]`},
+		{sql: `SELECT MAP {1.5::DECIMAL(4, 1): 'sad'::mood}`, typeName: `MAP(DECIMAL(4,1), ENUM('happy', 'it''s ok', 'sad'))`},
+		{sql: `SELECT MAP {'a': [1, 2]}`, typeName: `MAP(VARCHAR, INTEGER[])`},
+		{sql: `SELECT {'a': 1, 'b': ['x']}`, typeName: `STRUCT("a" INTEGER, "b" VARCHAR[])`},
+		{sql: `SELECT [{'a': 'happy'::mood}, NULL]::STRUCT(a mood)[2]`, typeName: `STRUCT("a" ENUM('happy', 'it''s ok', 'sad'))[2]`},
+		{sql: `SELECT 1::UNION(num INTEGER, str VARCHAR)`, typeName: `UNION("num" INTEGER, "str" VARCHAR)`},
+	}
+
+	for _, test := range tests {
+		r, err := db.Query(test.sql)
+		require.NoError(t, err)
+		cols, err := r.ColumnTypes()
+		require.NoError(t, err)
+		require.Equal(t, test.typeName, cols[0].DatabaseTypeName())
+		require.NoError(t, r.Close())
+
+		// The type names are valid SQL.
+		_, err = db.Exec(`CREATE OR REPLACE TABLE test AS SELECT (` + test.sql + `)::` + test.typeName + ` AS col`)
+		require.NoError(t, err, test.typeName)
+	}
+	require.NoError(t, db.Close())
+}
+
 // Running multiple statements in a single query. All statements except the last one are executed and if no error then last statement is executed with args and result returned.
 func TestMultipleStatements(t *testing.T) {
 	db := openDB(t)
@@ -630,6 +1030,106 @@ func TestMultipleStatements(t *testing.T) {
 	require.NoError(t, db.Close())
 }
 
+func TestExecScript(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	conn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	script := `
+		-- Create the table.
+		CREATE TABLE test (id INT PRIMARY KEY, name VARCHAR);;
+		/* Insert some rows. */
+		INSERT INTO test VALUES (1, 'a'), (2, 'b');
+		INSERT INTO test VALUES (3, 'c');
+		UPDATE test SET name = 'd' WHERE id > 1;
+		-- Trailing comment.
+	`
+	var count int
+	err = conn.Raw(func(driverConn any) error {
+		c := driverConn.(*Conn)
+		rowsAffected, err := c.ExecScript(ctx, script)
+		require.NoError(t, err)
+		require.Equal(t, int64(5), rowsAffected)
+		require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM test WHERE name = 'd'`).Scan(&count))
+		require.Equal(t, 2, count)
+
+		// Scripts without statements do nothing.
+		rowsAffected, err = c.ExecScript(ctx, `-- Nothing to do.`)
+		require.NoError(t, err)
+		require.Equal(t, int64(0), rowsAffected)
+
+		// The script stops at the first error.
+		rowsAffected, err = c.ExecScript(ctx, `
+			INSERT INTO test VALUES (4, 'e');
+			INSERT INTO test VALUES (1, 'duplicate');
+			INSERT INTO test VALUES (5, 'f');`)
+		require.ErrorContains(t, err, "Duplicate key")
+		require.ErrorContains(t, err, indexErrMsg+": 1")
+		require.Equal(t, int64(1), rowsAffected)
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, conn.QueryRowContext(ctx, `SELECT COUNT(*) FROM test`).Scan(&count))
+	require.Equal(t, 4, count)
+
+	require.NoError(t, conn.Close())
+	require.NoError(t, db.Close())
+}
+
+func TestConnSettings(t *testing.T) {
+	db := openDB(t)
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	require.NoError(t, err)
+	other, err := db.Conn(ctx)
+	require.NoError(t, err)
+
+	setting := func(c *sql.Conn, name string) string {
+		var value string
+		require.NoError(t, c.QueryRowContext(ctx, `SELECT current_setting(?)::VARCHAR`, name).Scan(&value))
+		return value
+	}
+
+	// Local settings only affect the connection.
+	require.NoError(t, conn.Raw(func(driverConn any) error {
+		return driverConn.(*Conn).SetSetting("perfect_ht_threshold", "5")
+	}))
+	require.Equal(t, "5", setting(conn, "perfect_ht_threshold"))
+	require.Equal(t, "12", setting(other, "perfect_ht_threshold"))
+
+	require.NoError(t, conn.Raw(func(driverConn any) error {
+		return driverConn.(*Conn).ResetSetting("perfect_ht_threshold")
+	}))
+	require.Equal(t, "12", setting(conn, "perfect_ht_threshold"))
+
+	// Global settings.
+	require.NoError(t, conn.Raw(func(driverConn any) error {
+		return driverConn.(*Conn).SetSetting("threads", "1")
+	}))
+	require.Equal(t, "1", setting(conn, "threads"))
+
+	// Unknown settings and invalid values return the DuckDB error.
+	err = conn.Raw(func(driverConn any) error {
+		return driverConn.(*Conn).SetSetting("unknown_setting", "1")
+	})
+	require.ErrorContains(t, err, `unrecognized configuration parameter "unknown_setting"`)
+	err = conn.Raw(func(driverConn any) error {
+		return driverConn.(*Conn).SetSetting("threads", "it's many")
+	})
+	require.ErrorContains(t, err, "Could not convert string 'it's many'")
+	err = conn.Raw(func(driverConn any) error {
+		return driverConn.(*Conn).ResetSetting("unknown_setting")
+	})
+	require.ErrorContains(t, err, `unrecognized configuration parameter "unknown_setting"`)
+
+	require.NoError(t, other.Close())
+	require.NoError(t, conn.Close())
+	require.NoError(t, db.Close())
+}
+
 func TestParquetExtension(t *testing.T) {
 	db := openDB(t)
 
@@ -674,6 +1174,144 @@ func TestQueryTimeout(t *testing.T) {
 	require.NoError(t, db.Close())
 }
 
+func TestConnectorQueryTimeout(t *testing.T) {
+	c, err := NewConnectorWithConfig("", ConnectorConfig{QueryTimeout: time.Second})
+	require.NoError(t, err)
+	db := sql.OpenDB(c)
+	const slowQuery = `SELECT SUM(t1.range * t2.range) FROM range(10000000) t1, range(1000000) t2`
+
+	now := time.Now()
+	_, err = db.Exec(slowQuery)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(now), 10*time.Second)
+
+	// An earlier deadline of the context wins.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	now = time.Now()
+	_, err = db.QueryContext(ctx, slowQuery)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(now), 900*time.Millisecond)
+
+	// A later deadline of the context does not extend the timeout.
+	ctx, cancel = context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	_, err = db.QueryContext(ctx, slowQuery)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.NoError(t, ctx.Err())
+
+	// Fast queries are unaffected.
+	var res int
+	require.NoError(t, db.QueryRow(`SELECT 42`).Scan(&res))
+	require.Equal(t, 42, res)
+
+	require.NoError(t, db.Close())
+}
+
+func TestConnectorStreamResults(t *testing.T) {
+	c, err := NewConnectorWithConfig("", ConnectorConfig{StreamResults: true})
+	require.NoError(t, err)
+	db := sql.OpenDB(c)
+	conn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+
+	// Materializing a billion rows exceeds the deadline, while streaming returns the first chunks immediately.
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	rows, err := conn.QueryContext(ctx, `SELECT range FROM range(1000000000)`)
+	require.NoError(t, err)
+	var i int64
+	for ; i < 100000 && rows.Next(); i++ {
+		var v int64
+		require.NoError(t, rows.Scan(&v))
+		require.Equal(t, i, v)
+	}
+	require.Equal(t, int64(100000), i)
+
+	// Closing the rows stops the query, and the connection remains usable.
+	require.NoError(t, rows.Close())
+	var res int
+	require.NoError(t, conn.QueryRowContext(context.Background(), `SELECT 42`).Scan(&res))
+	require.Equal(t, 42, res)
+
+	// Errors while fetching the chunks are returned by Next.
+	rows, err = conn.QueryContext(context.Background(), `SELECT CASE WHEN range = 1000000 THEN error('boom') ELSE range END FROM range(2000000)`)
+	require.NoError(t, err)
+	for rows.Next() {
+	}
+	require.ErrorContains(t, rows.Err(), "boom")
+	require.NoError(t, rows.Close())
+
+	// Canceling the context interrupts fetching the chunks.
+	ctx, cancel = context.WithCancel(context.Background())
+	rows, err = conn.QueryContext(ctx, `SELECT range FROM range(1000000000)`)
+	require.NoError(t, err)
+	require.True(t, rows.Next())
+	cancel()
+	for rows.Next() {
+	}
+	require.ErrorIs(t, rows.Err(), context.Canceled)
+	require.NoError(t, rows.Close())
+
+	require.NoError(t, conn.Close())
+	require.NoError(t, db.Close())
+}
+
+func TestConnectorInitStatements(t *testing.T) {
+	c, err := NewConnectorWithConfig("", ConnectorConfig{
+		InitStatements: []string{`CREATE SCHEMA IF NOT EXISTS init; CREATE OR REPLACE TABLE init.t AS SELECT 42 AS i`, `SET search_path = 'init'`},
+	})
+	require.NoError(t, err)
+	db := sql.OpenDB(c)
+	db.SetMaxIdleConns(0)
+
+	// Each new connection executes the statements.
+	for i := 0; i < 2; i++ {
+		var res int
+		require.NoError(t, db.QueryRow(`SELECT i FROM t`).Scan(&res))
+		require.Equal(t, 42, res)
+	}
+	require.NoError(t, db.Close())
+
+	// A failing statement fails creating the connection.
+	c, err = NewConnectorWithConfig("", ConnectorConfig{InitStatements: []string{`SELECT 1`, `LOAD not_exist`}})
+	require.NoError(t, err)
+	db = sql.OpenDB(c)
+	err = db.Ping()
+	require.ErrorIs(t, err, errInitStmt)
+	require.ErrorContains(t, err, "index: 1")
+	require.NoError(t, db.Close())
+}
+
+func TestQueryCancel(t *testing.T) {
+	db := openDB(t)
+	conn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		cancel()
+	}()
+
+	now := time.Now()
+	_, err = conn.QueryContext(ctx, `SELECT SUM(t1.range * t2.range) FROM range(10000000) t1, range(1000000) t2`)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Less(t, time.Since(now), 10*time.Second)
+
+	// An already canceled context does not execute the query.
+	_, err = conn.ExecContext(ctx, `CREATE TABLE test AS SELECT 42 AS v`)
+	require.ErrorIs(t, err, context.Canceled)
+
+	// The connection remains usable after the interrupt.
+	var res int
+	require.NoError(t, conn.QueryRowContext(context.Background(), `SELECT COUNT(*) FROM duckdb_tables() WHERE table_name = 'test'`).Scan(&res))
+	require.Equal(t, 0, res)
+
+	require.NoError(t, conn.Close())
+	require.NoError(t, db.Close())
+}
+
 func Example_simpleConnection() {
 	// Connect to DuckDB using '[database/sql.Open]'.
 	db, err := sql.Open("duckdb", "?access_mode=READ_WRITE")
@@ -696,6 +1334,39 @@ func Example_simpleConnection() {
 	// Output: Inserted 1 row(s) into users table
 }
 
+// BenchmarkScanRange scans one million rows of different types.
+func BenchmarkScanRange(b *testing.B) {
+	db, err := sql.Open("duckdb", "")
+	require.NoError(b, err)
+	_, err = db.Exec(`CREATE TYPE greeting AS ENUM ('hello', 'world', '!')`)
+	require.NoError(b, err)
+
+	queries := []struct {
+		name  string
+		query string
+	}{
+		{name: "BIGINT", query: `SELECT range FROM range(1000000)`},
+		{name: "DATE", query: `SELECT DATE '1992-09-20' + (range % 10000)::INT FROM range(1000000)`},
+		{name: "VARCHAR", query: `SELECT range::VARCHAR FROM range(1000000)`},
+		{name: "ENUM", query: `SELECT (['hello', 'world', '!'])[range % 3 + 1]::greeting FROM range(1000000)`},
+	}
+	for _, q := range queries {
+		b.Run(q.name, func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				res, err := db.Query(q.query)
+				require.NoError(b, err)
+				var v any
+				for res.Next() {
+					require.NoError(b, res.Scan(&v))
+				}
+				require.NoError(b, res.Err())
+				require.NoError(b, res.Close())
+			}
+		})
+	}
+	require.NoError(b, db.Close())
+}
+
 func openDB(t *testing.T) *sql.DB {
 	db, err := sql.Open("duckdb", "")
 	require.NoError(t, err)
@@ -0,0 +1,33 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+)
+
+// RegisterEnum creates the ENUM type name with the members, e.g., CREATE TYPE mood AS ENUM ('happy', 'sad').
+// *sql.Conn is the SQL connection on which to create the type.
+// T is the Go type of the members, so that the appender and parameters accept T values for columns of the ENUM type,
+// and scanning the columns into a *T returns the members. Appending a T that is not a member returns an error.
+// The members must be unique, and they must not contain NUL bytes.
+func RegisterEnum[T ~string](c *sql.Conn, name string, members []T) error {
+	if name == "" {
+		return getError(errAPI, errEmptyName)
+	}
+	if len(members) == 0 {
+		return getError(errAPI, errEmptyEnum)
+	}
+
+	names := make([]string, len(members))
+	for i, member := range members {
+		names[i] = string(member)
+	}
+	info, err := NewEnumInfo(names[0], names[1:]...)
+	if err != nil {
+		return err
+	}
+
+	return c.Raw(func(driverConn any) error {
+		return driverConn.(*Conn).CreateType(context.Background(), name, info)
+	})
+}
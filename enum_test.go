@@ -0,0 +1,70 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type mood string
+
+const (
+	moodHappy mood = "happy"
+	moodOK    mood = "it's ok"
+	moodSad   mood = "sad"
+)
+
+func TestRegisterEnum(t *testing.T) {
+	t.Parallel()
+	c, err := NewConnector("", nil)
+	require.NoError(t, err)
+	db := sql.OpenDB(c)
+	conn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+
+	require.NoError(t, RegisterEnum(conn, "mood", []mood{moodHappy, moodOK, moodSad}))
+	_, err = conn.ExecContext(context.Background(), `CREATE TABLE test (m mood, l mood[])`)
+	require.NoError(t, err)
+
+	// The appender accepts the members.
+	con, err := c.Connect(context.Background())
+	require.NoError(t, err)
+	a, err := NewAppenderFromConn(con, "", "test")
+	require.NoError(t, err)
+	require.NoError(t, a.AppendRow(moodOK, []mood{moodSad, moodHappy}))
+	err = a.AppendRow(mood("angry"), nil)
+	require.ErrorContains(t, err, `"angry"`)
+	require.ErrorContains(t, err, "an ENUM member")
+	require.NoError(t, a.Close())
+	require.NoError(t, con.Close())
+
+	// So do parameters, and scanning returns the members.
+	_, err = conn.ExecContext(context.Background(), `INSERT INTO test VALUES (?, NULL)`, moodHappy)
+	require.NoError(t, err)
+	rows, err := conn.QueryContext(context.Background(), `SELECT m FROM test ORDER BY m`)
+	require.NoError(t, err)
+	var moods []mood
+	for rows.Next() {
+		var m mood
+		require.NoError(t, rows.Scan(&m))
+		moods = append(moods, m)
+	}
+	require.NoError(t, rows.Err())
+	require.NoError(t, rows.Close())
+	require.Equal(t, []mood{moodHappy, moodOK}, moods)
+
+	var l List[mood]
+	require.NoError(t, conn.QueryRowContext(context.Background(), `SELECT l FROM test WHERE l IS NOT NULL`).Scan(&l))
+	require.Equal(t, []mood{moodSad, moodHappy}, l.Get())
+
+	err = RegisterEnum(conn, "empty", []mood{})
+	testError(t, err, errAPI.Error(), errEmptyEnum.Error())
+	err = RegisterEnum(conn, "duplicate", []mood{moodSad, moodSad})
+	testError(t, err, errAPI.Error(), duplicateNameErrMsg)
+
+	require.NoError(t, conn.Close())
+	require.NoError(t, db.Close())
+	require.NoError(t, c.Close())
+}
@@ -15,7 +15,7 @@ func getError(errDriver error, err error) error {
 }
 
 func duckdbError(err *C.char) error {
-	return fmt.Errorf("%s: %w", duckdbErrMsg, errors.New(C.GoString(err)))
+	return fmt.Errorf("%s: %w", duckdbErrMsg, getDuckDBError(C.GoString(err)))
 }
 
 func castError(actual string, expected string) error {
@@ -49,6 +49,10 @@ func invalidatedAppenderError(err error) error {
 	return fmt.Errorf("%w: %s", err, invalidatedAppenderMsg)
 }
 
+func appendValueError(err error, row int, column string, expected string, actual any) error {
+	return fmt.Errorf("%w: row=%d column=%s expected=%s got=%T", err, row, column, expected, actual)
+}
+
 func tryOtherFuncError(hint string) error {
 	return fmt.Errorf("%s: %s", tryOtherFuncErrMsg, hint)
 }
@@ -57,6 +61,10 @@ func addIndexToError(err error, idx int) error {
 	return fmt.Errorf("%w: %s: %d", err, indexErrMsg, idx)
 }
 
+func paramIndexError(idx int, max int) error {
+	return fmt.Errorf("%s: %d is not between 1 and %d", paramIndexErrMsg, idx, max)
+}
+
 func interfaceIsNilError(interfaceName string) error {
 	return fmt.Errorf("%s: %s", interfaceIsNilErrMsg, interfaceName)
 }
@@ -65,6 +73,18 @@ func duplicateNameError(name string) error {
 	return fmt.Errorf("%s: %s", duplicateNameErrMsg, name)
 }
 
+func typeInfoMismatchError(actual Type, expected ...Type) error {
+	names := make([]string, 0, len(expected))
+	for _, t := range expected {
+		names = append(names, typeToStringMap[t])
+	}
+	return fmt.Errorf("%s: expected %s, got %s", typeInfoMismatchErrMsg, strings.Join(names, " or "), typeToStringMap[actual])
+}
+
+func invalidDecimalError(err error, width uint8, scale uint8) error {
+	return fmt.Errorf("%w: got DECIMAL(%d, %d)", err, width, scale)
+}
+
 const (
 	driverErrMsg           = "database/sql/driver"
 	duckdbErrMsg           = "duckdb error"
@@ -77,9 +97,11 @@ const (
 	invalidatedAppenderMsg = "appended data has been invalidated due to corrupt row"
 	tryOtherFuncErrMsg     = "please try this function instead"
 	indexErrMsg            = "index"
+	paramIndexErrMsg       = "invalid parameter index"
 	unknownTypeErrMsg      = "unknown type"
 	interfaceIsNilErrMsg   = "interface is nil"
 	duplicateNameErrMsg    = "duplicate name"
+	typeInfoMismatchErrMsg = "type information mismatch"
 )
 
 var (
@@ -91,9 +113,22 @@ var (
 	errParseDSN     = errors.New("could not parse DSN for database")
 	errSetConfig    = errors.New("could not set invalid or local option for global database config")
 	errCreateConfig = errors.New("could not create config for database")
-
-	errInvalidCon = errors.New("not a DuckDB driver connection")
-	errClosedCon  = errors.New("closed connection")
+	errTimeZone     = errors.New("could not load time zone")
+	errInitStmt     = errors.New("could not execute connection initialization statement")
+	errMemoryConfig = errors.New("the named in-memory database is already open with a different configuration")
+
+	errInstallExtension = errors.New("could not install extension")
+	errLoadExtension    = errors.New("could not load extension")
+	errAttach           = errors.New("could not attach database")
+	errDetach           = errors.New("could not detach database")
+
+	errInvalidCon    = errors.New("not a DuckDB driver connection")
+	errClosedCon     = errors.New("closed connection")
+	errClosedStmt    = errors.New("closed statement")
+	errClosedPending = errors.New("closed pending result")
+	errActiveRows    = errors.New("cannot execute a statement with active rows")
+	errInvalidRows   = errors.New("not DuckDB driver rows")
+	errNoChunk       = errors.New("no current chunk: call NextChunk first")
 
 	errPrepare                    = errors.New("could not prepare query")
 	errMissingPrepareContext      = errors.New("missing context for multi-statement query: try using PrepareContext")
@@ -102,6 +137,7 @@ var (
 	errMultipleTx                 = errors.New("multiple transactions")
 	errReadOnlyTxNotSupported     = errors.New("read-only transactions are not supported")
 	errIsolationLevelNotSupported = errors.New("isolation level not supported: go-duckdb only supports the default isolation level")
+	errLastInsertIDNotSupported   = errors.New("LastInsertId is not supported: use a RETURNING clause to obtain inserted values")
 
 	errAppenderCreation         = errors.New("could not create appender")
 	errAppenderClose            = errors.New("could not close appender")
@@ -109,23 +145,41 @@ var (
 	errAppenderAppendRow        = errors.New("could not append row")
 	errAppenderAppendAfterClose = fmt.Errorf("%w: appender already closed", errAppenderAppendRow)
 	errAppenderFlush            = errors.New("could not flush appender")
+	errAppenderChunkSize        = errors.New("could not set appender chunk size")
+	errAppenderAppendArrow      = errors.New("could not append Arrow record")
+	errAppenderTxEnded          = errors.New("the appender's transaction has ended")
 
 	errUnsupportedMapKeyType = errors.New("MAP key type not supported")
 	errEmptyName             = errors.New("empty name")
-	errInvalidDecimalWidth   = fmt.Errorf("the DECIMAL with must be between 1 and %d", max_decimal_width)
+	errTypeExists            = errors.New("type already exists")
+	errNULByteInName         = errors.New("name contains a NUL byte")
+	errInvalidDecimalWidth   = fmt.Errorf("the DECIMAL width must be between 1 and %d", max_decimal_width)
 	errInvalidDecimalScale   = errors.New("the DECIMAL scale must be less than or equal to the width")
-	errInvalidArraySize      = errors.New("invalid ARRAY size")
+	errInvalidArraySize      = fmt.Errorf("invalid ARRAY size: the ARRAY size must be between 1 and %d", max_array_size)
+	errEmptyEnum             = errors.New("an ENUM must have at least one member")
+	errEmptyUnion            = errors.New("a UNION must have at least one member")
+	errUnionMemberCount      = errors.New("the number of UNION member types must match the number of member names")
 	errSetSQLNULLValue       = errors.New("cannot write to a NULL column")
 
-	errScalarUDFCreate          = errors.New("could not create scalar UDF")
-	errScalarUDFNoName          = fmt.Errorf("%w: missing name", errScalarUDFCreate)
-	errScalarUDFIsNil           = fmt.Errorf("%w: function is nil", errScalarUDFCreate)
-	errScalarUDFNoExecutor      = fmt.Errorf("%w: executor is nil", errScalarUDFCreate)
-	errScalarUDFInputTypeIsNil  = fmt.Errorf("%w: input type is nil", errScalarUDFCreate)
-	errScalarUDFResultTypeIsNil = fmt.Errorf("%w: result type is nil", errScalarUDFCreate)
-	errScalarUDFResultTypeIsANY = fmt.Errorf("%w: result type is ANY, which is not supported", errScalarUDFCreate)
-	errScalarUDFCreateSet       = fmt.Errorf("could not create scalar UDF set")
-	errScalarUDFAddToSet        = fmt.Errorf("%w: could not add the function to the set", errScalarUDFCreateSet)
+	errScalarUDFCreate            = errors.New("could not create scalar UDF")
+	errScalarUDFNoName            = fmt.Errorf("%w: missing name", errScalarUDFCreate)
+	errScalarUDFIsNil             = fmt.Errorf("%w: function is nil", errScalarUDFCreate)
+	errScalarUDFNoExecutor        = fmt.Errorf("%w: executor is nil", errScalarUDFCreate)
+	errScalarUDFMultipleExecutors = fmt.Errorf("%w: both the row executor and the chunk executor are set", errScalarUDFCreate)
+	errScalarUDFChunkNullHandling = fmt.Errorf("%w: the chunk executor does not support special NULL handling", errScalarUDFCreate)
+	errScalarUDFInputTypeIsNil    = fmt.Errorf("%w: input type is nil", errScalarUDFCreate)
+	errScalarUDFResultTypeIsNil   = fmt.Errorf("%w: result type is nil", errScalarUDFCreate)
+	errScalarUDFResultTypeIsANY   = fmt.Errorf("%w: result type is ANY, which is not supported", errScalarUDFCreate)
+	errScalarUDFCreateSet         = fmt.Errorf("could not create scalar UDF set")
+	errScalarUDFAddToSet          = fmt.Errorf("%w: could not add the function to the set", errScalarUDFCreateSet)
+
+	errAggregateUDFCreate          = errors.New("could not create aggregate UDF")
+	errAggregateUDFNoName          = fmt.Errorf("%w: missing name", errAggregateUDFCreate)
+	errAggregateUDFIsNil           = fmt.Errorf("%w: function is nil", errAggregateUDFCreate)
+	errAggregateUDFNoExecutor      = fmt.Errorf("%w: executor is missing the Init, Update, Combine, or Finalize function", errAggregateUDFCreate)
+	errAggregateUDFInputTypeIsNil  = fmt.Errorf("%w: input type is nil", errAggregateUDFCreate)
+	errAggregateUDFResultTypeIsNil = fmt.Errorf("%w: result type is nil", errAggregateUDFCreate)
+	errAggregateUDFResultTypeIsANY = fmt.Errorf("%w: result type is ANY, which is not supported", errAggregateUDFCreate)
 
 	errTableUDFCreate          = errors.New("could not create table UDF")
 	errTableUDFNoName          = fmt.Errorf("%w: missing name", errTableUDFCreate)
@@ -230,15 +284,43 @@ var errorPrefixMap = map[string]ErrorType{
 	"Invalid Configuration Error":  ErrorTypeInvalidConfiguration,
 }
 
+// Error is an error returned by DuckDB. All errors of executing a query, including the errors of
+// the appender and of the Arrow interface, wrap an *Error, which errors.As extracts.
 type Error struct {
+	// Type is the classification of the error, which DuckDB's error message prefix encodes.
+	// DuckDB omits the prefix in appender errors, so their Type is ErrorTypeInvalid.
 	Type ErrorType
-	Msg  string
+	// Msg is the complete error message, e.g., "Catalog Error: Table with name foo does not exist!".
+	Msg string
 }
 
 func (e *Error) Error() string {
 	return e.Msg
 }
 
+// Message returns the error message without its error type prefix,
+// e.g., "Table with name foo does not exist!" for a Catalog Error.
+func (e *Error) Message() string {
+	if idx := strings.Index(e.Msg, ": "); idx != -1 {
+		if _, ok := errorPrefixMap[e.Msg[:idx]]; ok {
+			return e.Msg[idx+2:]
+		}
+	}
+	return e.Msg
+}
+
+// IsConstraintViolation returns true, if a statement violated a constraint,
+// e.g., a duplicate key of a PRIMARY KEY or UNIQUE constraint, or a NULL value in a NOT NULL column.
+func (e *Error) IsConstraintViolation() bool {
+	return e.Type == ErrorTypeConstraint
+}
+
+// IsTransactionConflict returns true, if a statement conflicted with a concurrent transaction,
+// e.g., by updating the same row. Retrying the transaction can succeed.
+func (e *Error) IsTransactionConflict() bool {
+	return e.Type == ErrorTypeTransaction
+}
+
 func (e *Error) Is(err error) bool {
 	if other, ok := err.(*Error); ok {
 		return other.Msg == e.Msg
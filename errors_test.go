@@ -27,7 +27,7 @@ func testError(t *testing.T, actual error, contains ...string) {
 
 func TestErrConnect(t *testing.T) {
 	t.Run(errParseDSN.Error(), func(t *testing.T) {
-		_, err := sql.Open("duckdb", ":mem ory:")
+		_, err := sql.Open("duckdb", "?threads=%zz")
 		testError(t, err, errParseDSN.Error())
 	})
 
@@ -54,6 +54,11 @@ func TestErrNestedMap(t *testing.T) {
 	var m Map
 	err := db.QueryRow(`SELECT MAP([MAP([1], [1]), MAP([2], [2])], ['a', 'e'])`).Scan(&m)
 	testError(t, err, errUnsupportedMapKeyType.Error())
+
+	err = db.QueryRow(`SELECT MAP {1::UNION(i INT, l INT[]): 'a'}`).Scan(&m)
+	testError(t, err, errUnsupportedMapKeyType.Error())
+	err = db.QueryRow(`SELECT MAP {[1]::UNION(i INT, l INT[]): 'a'}`).Scan(&m)
+	testError(t, err, errUnsupportedMapKeyType.Error())
 	require.NoError(t, db.Close())
 }
 
@@ -110,23 +115,6 @@ func TestErrAppender(t *testing.T) {
 		testError(t, err, errAppenderDoubleClose.Error())
 	})
 
-	t.Run(unsupportedTypeErrMsg, func(t *testing.T) {
-		c, err := NewConnector("", nil)
-		require.NoError(t, err)
-
-		_, err = sql.OpenDB(c).Exec(`CREATE TABLE test (bit_col BIT)`)
-		require.NoError(t, err)
-
-		con, err := c.Connect(context.Background())
-		require.NoError(t, err)
-
-		_, err = NewAppenderFromConn(con, "", "test")
-		testError(t, err, errAppenderCreation.Error(), unsupportedTypeErrMsg)
-
-		require.NoError(t, con.Close())
-		require.NoError(t, c.Close())
-	})
-
 	t.Run(columnCountErrMsg, func(t *testing.T) {
 		c, con, a := prepareAppender(t, `CREATE TABLE test (a VARCHAR, b VARCHAR)`)
 		err := a.AppendRow("hello")
@@ -191,6 +179,42 @@ func TestErrAppend(t *testing.T) {
 	cleanupAppender(t, c, con, a)
 }
 
+func TestErrAppenderChunkSize(t *testing.T) {
+	c, con, a := prepareAppender(t, `CREATE TABLE test (id BIGINT)`)
+
+	err := a.SetChunkSize(0)
+	testError(t, err, errAppenderChunkSize.Error(), invalidInputErrMsg)
+	err = a.SetChunkSize(GetDataChunkCapacity() + 1)
+	testError(t, err, errAppenderChunkSize.Error(), invalidInputErrMsg)
+
+	cleanupAppender(t, c, con, a)
+	err = a.SetChunkSize(1)
+	testError(t, err, errAppenderAppendAfterClose.Error())
+}
+
+func TestErrAppendContext(t *testing.T) {
+	c, con, a := prepareAppender(t, `CREATE TABLE test (x INT); CREATE SCHEMA s; CREATE TABLE s.test (id BIGINT, "my str" VARCHAR)`)
+	require.NoError(t, a.Close())
+	a, err := NewAppenderFromConn(con, "s", "test")
+	require.NoError(t, err)
+
+	require.NoError(t, a.AppendRow(int64(1), "a"))
+	require.NoError(t, a.AppendRow(int64(2), "b"))
+
+	// The error contains the row index, the column name and type, and the Go type.
+	err = a.AppendRow("hello", "world")
+	testError(t, err, errAppenderAppendRow.Error(), castErrMsg, "row=2 column=id expected=BIGINT got=string")
+	err = a.AppendRow(int64(3), 42)
+	testError(t, err, errAppenderAppendRow.Error(), castErrMsg, "row=2 column=my str expected=VARCHAR got=int")
+
+	// Failing rows are not appended.
+	require.NoError(t, a.AppendRow(int64(3), "c"))
+	err = a.AppendRow(int64(4), []int{1})
+	testError(t, err, errAppenderAppendRow.Error(), castErrMsg, "row=3 column=my str expected=VARCHAR got=[]int")
+
+	cleanupAppender(t, c, con, a)
+}
+
 func TestErrAppendDecimal(t *testing.T) {
 	c, con, a := prepareAppender(t, `CREATE TABLE test (d DECIMAL(8, 2))`)
 
@@ -205,6 +229,12 @@ func TestErrAppendDecimal(t *testing.T) {
 func TestErrAppendEnum(t *testing.T) {
 	c, con, a := prepareAppender(t, testTypesEnumSQL+";"+`CREATE TABLE test (e my_enum)`)
 	err := a.AppendRow("3")
+	testError(t, err, errAppenderAppendRow.Error(), invalidInputErrMsg, "an ENUM member")
+	err = a.AppendRow(3)
+	testError(t, err, errAppenderAppendRow.Error(), invalidInputErrMsg, "an ENUM index below 3")
+	err = a.AppendRow(-1)
+	testError(t, err, errAppenderAppendRow.Error(), invalidInputErrMsg)
+	err = a.AppendRow(1.5)
 	testError(t, err, errAppenderAppendRow.Error(), castErrMsg)
 	cleanupAppender(t, c, con, a)
 }
@@ -257,6 +287,29 @@ func TestErrAppendDuplicateStruct(t *testing.T) {
 	cleanupAppender(t, c, con, a)
 }
 
+func TestErrAppendStructUnknownField(t *testing.T) {
+	c, con, a := prepareAppender(t, `
+		CREATE TABLE test (
+			simple_struct STRUCT(a INT, B VARCHAR)
+		)`)
+
+	type extraField struct {
+		A int32 `db:"a"`
+		B string
+		C string
+	}
+	err := a.AppendRow(extraField{1, "hello", "world"})
+	testError(t, err, errAppenderAppendRow.Error(), structFieldErrMsg, "unknown field C")
+
+	err = a.AppendRow(map[string]any{"a": int32(1), "B": "hello", "c": "world"})
+	testError(t, err, errAppenderAppendRow.Error(), structFieldErrMsg, "unknown field c")
+
+	err = a.AppendRow(map[int]any{1: "hello"})
+	testError(t, err, errAppenderAppendRow.Error(), castErrMsg)
+
+	cleanupAppender(t, c, con, a)
+}
+
 func TestErrAppendStruct(t *testing.T) {
 	c, con, a := prepareAppender(t, `
 		CREATE TABLE test (
@@ -450,6 +503,72 @@ func TestDuckDBErrorsCornerCases(t *testing.T) {
 	}
 }
 
+func TestDuckDBErrorClassification(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	_, err := db.Exec(`CREATE TABLE t (id INTEGER PRIMARY KEY, v INTEGER NOT NULL); INSERT INTO t VALUES (1, 1)`)
+	require.NoError(t, err)
+
+	var dbErr *Error
+	_, err = db.Exec(`INSERT INTO t VALUES (1, 2)`)
+	require.ErrorAs(t, err, &dbErr)
+	require.True(t, dbErr.IsConstraintViolation())
+	require.False(t, dbErr.IsTransactionConflict())
+	require.True(t, strings.HasPrefix(dbErr.Message(), `Duplicate key "id: 1"`))
+
+	_, err = db.Exec(`INSERT INTO t VALUES (2, NULL)`)
+	require.ErrorAs(t, err, &dbErr)
+	require.True(t, dbErr.IsConstraintViolation())
+
+	_, err = db.Exec(`SELECT * FROM not_exist`)
+	require.ErrorAs(t, err, &dbErr)
+	require.Equal(t, ErrorTypeCatalog, dbErr.Type)
+	require.False(t, dbErr.IsConstraintViolation())
+	require.Equal(t, "Catalog Error: "+dbErr.Message(), dbErr.Msg)
+
+	// Concurrent updates of the same row conflict.
+	ctx := context.Background()
+	conn1, err := db.Conn(ctx)
+	require.NoError(t, err)
+	conn2, err := db.Conn(ctx)
+	require.NoError(t, err)
+	tx1, err := conn1.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	tx2, err := conn2.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	_, err = tx1.Exec(`UPDATE t SET v = 2 WHERE id = 1`)
+	require.NoError(t, err)
+	_, err = tx2.Exec(`UPDATE t SET v = 3 WHERE id = 1`)
+	require.ErrorAs(t, err, &dbErr)
+	require.True(t, dbErr.IsTransactionConflict())
+	require.NoError(t, tx1.Commit())
+	require.NoError(t, tx2.Rollback())
+	require.NoError(t, conn1.Close())
+	require.NoError(t, conn2.Close())
+
+	// Appender errors also wrap an *Error.
+	conn, err := db.Conn(ctx)
+	require.NoError(t, err)
+	err = conn.Raw(func(driverConn any) error {
+		a, err := NewAppenderFromConn(driverConn.(driver.Conn), "", "t")
+		require.NoError(t, err)
+		require.NoError(t, a.AppendRow(int32(1), int32(1)))
+		err = a.Close()
+		require.ErrorAs(t, err, &dbErr)
+		// DuckDB omits the error type prefix in appender errors.
+		require.Equal(t, ErrorTypeInvalid, dbErr.Type)
+		require.Contains(t, dbErr.Message(), `Duplicate key "id: 1"`)
+		return nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+
+	// Messages without a known prefix remain unchanged.
+	require.Equal(t, "Unknown", (&Error{Msg: "Unknown"}).Message())
+	require.Equal(t, "Foo Error: xxx", (&Error{Msg: "Foo Error: xxx"}).Message())
+	require.NoError(t, db.Close())
+}
+
 type wrappedDuckDBError struct {
 	e *Error
 }
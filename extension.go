@@ -0,0 +1,89 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql/driver"
+	"io"
+)
+
+// ExtensionInfo describes a DuckDB extension, as listed by duckdb_extensions().
+type ExtensionInfo struct {
+	// Name is the name of the extension, e.g., httpfs.
+	Name string
+	// Loaded is true, if the extension is loaded.
+	Loaded bool
+	// Installed is true, if the extension is installed.
+	Installed bool
+	// InstallPath is the path of the installed extension, or (BUILT-IN) for statically linked extensions.
+	InstallPath string
+	// Description describes the extension.
+	Description string
+	// Aliases are alternative names of the extension.
+	Aliases []string
+	// Version is the version of the installed extension, or empty.
+	Version string
+	// InstallMode is the way the extension was installed, e.g., REPOSITORY or STATICALLY_LINKED, or empty.
+	InstallMode string
+}
+
+// InstallExtension runs INSTALL on this connection to download and install the extension name,
+// e.g., InstallExtension("httpfs"). Installing requires network access to the extension repository,
+// unless name is the path of an extension file.
+func (c *Conn) InstallExtension(name string) error {
+	if name == "" {
+		return getError(errAPI, errEmptyName)
+	}
+	if _, err := c.ExecContext(context.Background(), "INSTALL "+quoteLiteral(name), nil); err != nil {
+		return getError(errInstallExtension, err)
+	}
+	return nil
+}
+
+// LoadExtension runs LOAD on this connection to load the installed extension name, e.g., LoadExtension("httpfs").
+func (c *Conn) LoadExtension(name string) error {
+	if name == "" {
+		return getError(errAPI, errEmptyName)
+	}
+	if _, err := c.ExecContext(context.Background(), "LOAD "+quoteLiteral(name), nil); err != nil {
+		return getError(errLoadExtension, err)
+	}
+	return nil
+}
+
+// Extensions returns the extensions known to DuckDB, including the installed and the loaded extensions.
+func (c *Conn) Extensions() ([]ExtensionInfo, error) {
+	rows, err := c.QueryContext(context.Background(), `SELECT extension_name, loaded, installed, install_path,
+		description, aliases, extension_version, install_mode FROM duckdb_extensions() ORDER BY extension_name`, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var extensions []ExtensionInfo
+	values := make([]driver.Value, 8)
+	for {
+		if err = rows.Next(values); err == io.EOF {
+			return extensions, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		info := ExtensionInfo{
+			Name:      values[0].(string),
+			Loaded:    values[1].(bool),
+			Installed: values[2].(bool),
+		}
+		info.InstallPath, _ = values[3].(string)
+		info.Description, _ = values[4].(string)
+		aliases, _ := values[5].([]any)
+		for _, alias := range aliases {
+			if s, ok := alias.(string); ok {
+				info.Aliases = append(info.Aliases, s)
+			}
+		}
+		info.Version, _ = values[6].(string)
+		info.InstallMode, _ = values[7].(string)
+		extensions = append(extensions, info)
+	}
+}
@@ -0,0 +1,44 @@
+package duckdb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtensions(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	conn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+
+	err = conn.Raw(func(driverConn any) error {
+		c := driverConn.(*Conn)
+
+		// The JSON extension is statically linked.
+		require.NoError(t, c.LoadExtension("json"))
+		extensions, err := c.Extensions()
+		require.NoError(t, err)
+		var found bool
+		for _, info := range extensions {
+			if info.Name == "json" {
+				found = true
+				require.True(t, info.Loaded)
+				require.True(t, info.Installed)
+				require.Equal(t, "STATICALLY_LINKED", info.InstallMode)
+				require.NotEmpty(t, info.Description)
+			}
+		}
+		require.True(t, found)
+
+		err = c.LoadExtension("not_exist")
+		testError(t, err, errLoadExtension.Error(), "not_exist")
+		err = c.InstallExtension("")
+		testError(t, err, errAPI.Error(), errEmptyName.Error())
+		return nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+	require.NoError(t, db.Close())
+}
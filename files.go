@@ -0,0 +1,289 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// CSVOptions configure reading a CSV file with ReadCSV.
+// DuckDB auto-detects all options with a zero value.
+type CSVOptions struct {
+	// Delimiter separates the columns, e.g., "," or "\t".
+	Delimiter string
+	// Header specifies whether the first line contains the column names.
+	// A nil Header auto-detects the header.
+	Header *bool
+	// Columns are the names and types of the columns, which disables auto-detecting them.
+	Columns []StructField
+	// NullStr is the string representing a NULL value, e.g., "NA".
+	NullStr string
+	// SampleSize is the number of rows to sample for auto-detecting the options.
+	// A SampleSize of -1 samples all rows.
+	SampleSize int
+}
+
+// ReadCSV queries the CSV file at path with DuckDB's read_csv function, which it configures with opts.
+// *sql.Conn is the SQL connection on which to execute the query. The caller must close the returned rows.
+func ReadCSV(ctx context.Context, c *sql.Conn, path string, opts CSVOptions) (*sql.Rows, error) {
+	query, err := readCSVQuery(path, opts)
+	if err != nil {
+		return nil, err
+	}
+	return c.QueryContext(ctx, query)
+}
+
+func readCSVQuery(path string, opts CSVOptions) (string, error) {
+	args := []string{quoteLiteral(path)}
+	if opts.Delimiter != "" {
+		args = append(args, "delim = "+quoteLiteral(opts.Delimiter))
+	}
+	if opts.Header != nil {
+		args = append(args, "header = "+strconv.FormatBool(*opts.Header))
+	}
+	if len(opts.Columns) != 0 {
+		columns := make([]string, len(opts.Columns))
+		for i, field := range opts.Columns {
+			if field.Type == nil {
+				return "", getError(errAPI, addIndexToError(interfaceIsNilError("field.Type"), i))
+			}
+			columns[i] = quoteLiteral(field.Name) + ": " + quoteLiteral(field.Type.String())
+		}
+		args = append(args, "columns = {"+strings.Join(columns, ", ")+"}")
+	}
+	if opts.NullStr != "" {
+		args = append(args, "nullstr = "+quoteLiteral(opts.NullStr))
+	}
+	if opts.SampleSize != 0 {
+		args = append(args, "sample_size = "+strconv.Itoa(opts.SampleSize))
+	}
+	return "SELECT * FROM read_csv(" + strings.Join(args, ", ") + ")", nil
+}
+
+// JSONOptions configure reading a JSON file with ReadJSON.
+// DuckDB auto-detects all options with a zero value.
+type JSONOptions struct {
+	// Format is the layout of the JSON values, i.e., auto, newline_delimited, array, or unstructured.
+	Format string
+	// Columns are the names and types of the columns, which disables auto-detecting them.
+	// Nested JSON objects and arrays map to STRUCT and LIST columns.
+	Columns []StructField
+	// SampleSize is the number of objects to sample for auto-detecting the column types.
+	// A SampleSize of -1 samples all objects.
+	SampleSize int
+	// MaximumDepth is the maximum nesting depth for auto-detecting the column types.
+	// Deeper values are JSON columns. A MaximumDepth of -1 detects all nesting levels.
+	MaximumDepth int
+}
+
+// jsonFormats are the formats of JSONOptions.
+var jsonFormats = []string{"auto", "newline_delimited", "array", "unstructured"}
+
+// ReadJSON queries the JSON file at path with DuckDB's read_json function, which it configures with opts.
+// *sql.Conn is the SQL connection on which to execute the query. The caller must close the returned rows.
+func ReadJSON(ctx context.Context, c *sql.Conn, path string, opts JSONOptions) (*sql.Rows, error) {
+	query, err := readJSONQuery(path, opts)
+	if err != nil {
+		return nil, err
+	}
+	return c.QueryContext(ctx, query)
+}
+
+func readJSONQuery(path string, opts JSONOptions) (string, error) {
+	args := []string{quoteLiteral(path)}
+	if opts.Format != "" {
+		format := strings.ToLower(opts.Format)
+		if !slices.Contains(jsonFormats, format) {
+			expected := "one of " + strings.Join(jsonFormats, ", ")
+			return "", getError(errAPI, invalidInputError(strconv.Quote(opts.Format), expected))
+		}
+		args = append(args, "format = "+quoteLiteral(format))
+	}
+	if len(opts.Columns) != 0 {
+		columns := make([]string, len(opts.Columns))
+		for i, field := range opts.Columns {
+			if field.Type == nil {
+				return "", getError(errAPI, addIndexToError(interfaceIsNilError("field.Type"), i))
+			}
+			columns[i] = quoteLiteral(field.Name) + ": " + quoteLiteral(field.Type.String())
+		}
+		args = append(args, "columns = {"+strings.Join(columns, ", ")+"}")
+	}
+	if opts.SampleSize != 0 {
+		args = append(args, "sample_size = "+strconv.Itoa(opts.SampleSize))
+	}
+	if opts.MaximumDepth != 0 {
+		args = append(args, "maximum_depth = "+strconv.Itoa(opts.MaximumDepth))
+	}
+	return "SELECT * FROM read_json(" + strings.Join(args, ", ") + ")", nil
+}
+
+// ReadBlob queries the files matching the glob pattern with DuckDB's read_blob function, e.g., "data/*.png".
+// *sql.Conn is the SQL connection on which to execute the query.
+// The rows have a filename column, and a BLOB content column, which scans into a []byte.
+// ReadBlob returns an error, if no files match the pattern. The caller must close the returned rows.
+func ReadBlob(ctx context.Context, c *sql.Conn, glob string) (*sql.Rows, error) {
+	return readFiles(ctx, c, "read_blob", glob)
+}
+
+// ReadText queries the files matching the glob pattern with DuckDB's read_text function, e.g., "docs/*.md".
+// *sql.Conn is the SQL connection on which to execute the query.
+// The rows have a filename column, and a VARCHAR content column, which scans into a string.
+// The files must be valid UTF-8. ReadText returns an error, if no files match the pattern.
+// The caller must close the returned rows.
+func ReadText(ctx context.Context, c *sql.Conn, glob string) (*sql.Rows, error) {
+	return readFiles(ctx, c, "read_text", glob)
+}
+
+func readFiles(ctx context.Context, c *sql.Conn, function, glob string) (*sql.Rows, error) {
+	// DuckDB returns no rows for patterns without matching files.
+	var count int64
+	if err := c.QueryRowContext(ctx, "SELECT count(*) FROM glob("+quoteLiteral(glob)+")").Scan(&count); err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return nil, getError(errAPI, invalidInputError(strconv.Quote(glob), "a pattern matching at least one file"))
+	}
+	return c.QueryContext(ctx, "SELECT filename, content FROM "+function+"("+quoteLiteral(glob)+")")
+}
+
+// ParquetOptions configure writing a Parquet file with ExportParquet.
+// DuckDB's defaults apply to all options with a zero value.
+type ParquetOptions struct {
+	// Compression is the compression codec, i.e., uncompressed, snappy, gzip, zstd, brotli, lz4, or lz4_raw.
+	Compression string
+	// RowGroupSize is the number of rows per row group.
+	RowGroupSize int
+}
+
+// parquetCompressions are the compression codecs of ParquetOptions.
+var parquetCompressions = []string{"uncompressed", "snappy", "gzip", "zstd", "brotli", "lz4", "lz4_raw"}
+
+// ExportParquet writes the result of the query to the Parquet file at path, which it configures with opts.
+// ExportParquet returns the number of written rows.
+func (c *Conn) ExportParquet(ctx context.Context, query, path string, opts ParquetOptions) (int64, error) {
+	copyStmt, err := exportParquetQuery(query, path, opts)
+	if err != nil {
+		return 0, err
+	}
+	res, err := c.ExecContext(ctx, copyStmt, nil)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+func exportParquetQuery(query, path string, opts ParquetOptions) (string, error) {
+	options := []string{"FORMAT PARQUET"}
+	if opts.Compression != "" {
+		compression := strings.ToLower(opts.Compression)
+		if !slices.Contains(parquetCompressions, compression) {
+			expected := "one of " + strings.Join(parquetCompressions, ", ")
+			return "", getError(errAPI, invalidInputError(strconv.Quote(opts.Compression), expected))
+		}
+		options = append(options, "COMPRESSION "+compression)
+	}
+	if opts.RowGroupSize < 0 {
+		return "", getError(errAPI, invalidInputError(strconv.Itoa(opts.RowGroupSize), "a positive row group size"))
+	}
+	if opts.RowGroupSize != 0 {
+		options = append(options, "ROW_GROUP_SIZE "+strconv.Itoa(opts.RowGroupSize))
+	}
+
+	// Remove trailing semicolons, which are invalid in the subquery.
+	// The newline ends a trailing line comment of the query.
+	query = strings.TrimRight(strings.TrimSpace(query), ";")
+	return "COPY (" + query + "\n) TO " + quoteLiteral(path) + " (" + strings.Join(options, ", ") + ")", nil
+}
+
+// CopyOptions configure importing a file with CopyFrom.
+// DuckDB's defaults apply to all options with a zero value.
+type CopyOptions struct {
+	// Format is the file format, i.e., csv, parquet, or json.
+	// An empty Format detects the format from the file extension, and defaults to csv.
+	Format string
+	// Delimiter separates the columns of a CSV file, e.g., "," or "\t".
+	Delimiter string
+	// Header specifies whether the first line of a CSV file contains the column names.
+	// A nil Header auto-detects the header.
+	Header *bool
+	// NullStr is the string representing a NULL value in a CSV file, e.g., "NA".
+	NullStr string
+}
+
+// copyFormats are the formats of CopyOptions.
+var copyFormats = []string{"csv", "parquet", "json"}
+
+// CopyFrom imports the file at path into the existing table with DuckDB's COPY statement, which it configures with opts.
+// The table is in the connection's current schema. CopyFrom returns the number of imported rows.
+func (c *Conn) CopyFrom(ctx context.Context, table, path string, opts CopyOptions) (int64, error) {
+	if table == "" {
+		return 0, getError(errAPI, errEmptyName)
+	}
+	copyStmt, err := copyFromQuery(table, path, opts)
+	if err != nil {
+		return 0, err
+	}
+	res, err := c.ExecContext(ctx, copyStmt, nil)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+func copyFromQuery(table, path string, opts CopyOptions) (string, error) {
+	format := strings.ToLower(opts.Format)
+	if format == "" {
+		format = copyFormat(path)
+	}
+	if !slices.Contains(copyFormats, format) {
+		expected := "one of " + strings.Join(copyFormats, ", ")
+		return "", getError(errAPI, invalidInputError(strconv.Quote(opts.Format), expected))
+	}
+
+	options := []string{"FORMAT " + format}
+	csvOption := func(option string) error {
+		if format != "csv" {
+			return getError(errAPI, invalidInputError(option+" for the "+format+" format", "a csv format"))
+		}
+		return nil
+	}
+	if opts.Delimiter != "" {
+		if err := csvOption("Delimiter"); err != nil {
+			return "", err
+		}
+		options = append(options, "DELIMITER "+quoteLiteral(opts.Delimiter))
+	}
+	if opts.Header != nil {
+		if err := csvOption("Header"); err != nil {
+			return "", err
+		}
+		options = append(options, "HEADER "+strconv.FormatBool(*opts.Header))
+	}
+	if opts.NullStr != "" {
+		if err := csvOption("NullStr"); err != nil {
+			return "", err
+		}
+		options = append(options, "NULL "+quoteLiteral(opts.NullStr))
+	}
+	return "COPY " + quoteIdentifier(table) + " FROM " + quoteLiteral(path) + " (" + strings.Join(options, ", ") + ")", nil
+}
+
+// copyFormat returns the format of the file at path, based on its extension.
+func copyFormat(path string) string {
+	ext := strings.ToLower(filepath.Ext(path))
+	// Compressed files have a second extension, e.g., data.csv.gz.
+	if ext == ".gz" || ext == ".zst" {
+		ext = strings.ToLower(filepath.Ext(strings.TrimSuffix(path, filepath.Ext(path))))
+	}
+	switch ext {
+	case ".parquet":
+		return "parquet"
+	case ".json", ".jsonl", ".ndjson":
+		return "json"
+	}
+	return "csv"
+}
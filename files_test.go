@@ -0,0 +1,423 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadCSV(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	require.NoError(t, err)
+
+	// The path contains a quote.
+	path := filepath.Join(t.TempDir(), "it's.csv")
+	require.NoError(t, os.WriteFile(path, []byte("id|name|score\n1|a|1.5\n2|NA|NA\n3|c|3\n"), 0o644))
+
+	integerInfo, err := NewTypeInfo(TYPE_INTEGER)
+	require.NoError(t, err)
+	varcharInfo, err := NewTypeInfo(TYPE_VARCHAR)
+	require.NoError(t, err)
+
+	header := true
+	rows, err := ReadCSV(ctx, conn, path, CSVOptions{
+		Delimiter:  "|",
+		Header:     &header,
+		Columns:    []StructField{{Name: "id", Type: integerInfo}, {Name: "my name", Type: varcharInfo}, {Name: "score", Type: varcharInfo}},
+		NullStr:    "NA",
+		SampleSize: -1,
+	})
+	require.NoError(t, err)
+	columns, err := rows.Columns()
+	require.NoError(t, err)
+	require.Equal(t, []string{"id", "my name", "score"}, columns)
+
+	type row struct {
+		id    int32
+		name  sql.NullString
+		score sql.NullString
+	}
+	var actual []row
+	for rows.Next() {
+		var r row
+		require.NoError(t, rows.Scan(&r.id, &r.name, &r.score))
+		actual = append(actual, r)
+	}
+	require.NoError(t, rows.Err())
+	require.NoError(t, rows.Close())
+
+	require.Equal(t, []row{
+		{1, sql.NullString{String: "a", Valid: true}, sql.NullString{String: "1.5", Valid: true}},
+		{2, sql.NullString{}, sql.NullString{}},
+		{3, sql.NullString{String: "c", Valid: true}, sql.NullString{String: "3", Valid: true}},
+	}, actual)
+
+	// Without a header, the first line is a row.
+	header = false
+	rows, err = ReadCSV(ctx, conn, path, CSVOptions{Delimiter: "|", Header: &header})
+	require.NoError(t, err)
+	count := 0
+	for rows.Next() {
+		count++
+	}
+	require.NoError(t, rows.Close())
+	require.Equal(t, 4, count)
+
+	// Auto-detect all options.
+	rows, err = ReadCSV(ctx, conn, path, CSVOptions{})
+	require.NoError(t, err)
+	columns, err = rows.Columns()
+	require.NoError(t, err)
+	require.Equal(t, []string{"id", "name", "score"}, columns)
+	require.NoError(t, rows.Close())
+
+	require.NoError(t, conn.Close())
+	require.NoError(t, db.Close())
+}
+
+func TestErrReadCSV(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	require.NoError(t, err)
+
+	_, err = ReadCSV(ctx, conn, "", CSVOptions{Columns: []StructField{{Name: "id"}}})
+	testError(t, err, errAPI.Error(), interfaceIsNilErrMsg)
+
+	_, err = ReadCSV(ctx, conn, filepath.Join(t.TempDir(), "missing.csv"), CSVOptions{})
+	require.ErrorContains(t, err, "No files found")
+
+	require.NoError(t, conn.Close())
+	require.NoError(t, db.Close())
+}
+
+func TestReadJSON(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "data.json")
+	data := `{"id": 1, "user": {"name": "a", "tags": ["x", "y"]}, "extra": true}
+{"id": 2, "user": {"name": "b", "tags": []}, "extra": false}
+{"id": 3, "user": null}
+`
+	require.NoError(t, os.WriteFile(path, []byte(data), 0o644))
+
+	bigintInfo, err := NewTypeInfo(TYPE_BIGINT)
+	require.NoError(t, err)
+	varcharInfo, err := NewTypeInfo(TYPE_VARCHAR)
+	require.NoError(t, err)
+	tagsInfo, err := NewListInfo(varcharInfo)
+	require.NoError(t, err)
+	nameEntry, err := NewStructEntry(varcharInfo, "name")
+	require.NoError(t, err)
+	tagsEntry, err := NewStructEntry(tagsInfo, "tags")
+	require.NoError(t, err)
+	userInfo, err := NewStructInfo(nameEntry, tagsEntry)
+	require.NoError(t, err)
+
+	type user struct {
+		Name string   `db:"name"`
+		Tags []string `db:"tags"`
+	}
+	type row struct {
+		id   int64
+		user *user
+	}
+
+	// Declare the columns. Unknown keys are ignored.
+	rows, err := ReadJSON(ctx, conn, path, JSONOptions{
+		Format:  "newline_delimited",
+		Columns: []StructField{{Name: "id", Type: bigintInfo}, {Name: "user", Type: userInfo}},
+	})
+	require.NoError(t, err)
+	columns, err := rows.Columns()
+	require.NoError(t, err)
+	require.Equal(t, []string{"id", "user"}, columns)
+
+	var actual []row
+	for rows.Next() {
+		var r row
+		var u Struct[*user]
+		require.NoError(t, rows.Scan(&r.id, &u))
+		r.user = u.Get()
+		actual = append(actual, r)
+	}
+	require.NoError(t, rows.Err())
+	require.NoError(t, rows.Close())
+	require.Equal(t, []row{
+		{1, &user{Name: "a", Tags: []string{"x", "y"}}},
+		{2, &user{Name: "b", Tags: []string{}}},
+		{3, nil},
+	}, actual)
+
+	// Auto-detect the nested column types.
+	rows, err = ReadJSON(ctx, conn, path, JSONOptions{SampleSize: -1})
+	require.NoError(t, err)
+	types, err := rows.ColumnTypes()
+	require.NoError(t, err)
+	var names []string
+	for _, typ := range types {
+		names = append(names, typ.DatabaseTypeName())
+	}
+	require.Equal(t, []string{"BIGINT", `STRUCT("name" VARCHAR, "tags" VARCHAR[])`, "BOOLEAN"}, names)
+	require.NoError(t, rows.Close())
+
+	// Limit the nesting depth of the detected types.
+	rows, err = ReadJSON(ctx, conn, path, JSONOptions{MaximumDepth: 1})
+	require.NoError(t, err)
+	types, err = rows.ColumnTypes()
+	require.NoError(t, err)
+	// The JSON type is an alias of VARCHAR.
+	require.Equal(t, "VARCHAR", types[1].DatabaseTypeName())
+	require.NoError(t, rows.Close())
+
+	require.NoError(t, conn.Close())
+	require.NoError(t, db.Close())
+}
+
+func TestErrReadJSON(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	require.NoError(t, err)
+
+	_, err = ReadJSON(ctx, conn, "", JSONOptions{Columns: []StructField{{Name: "id"}}})
+	testError(t, err, errAPI.Error(), interfaceIsNilErrMsg)
+
+	_, err = ReadJSON(ctx, conn, "", JSONOptions{Format: "records"})
+	testError(t, err, errAPI.Error(), invalidInputErrMsg, `"records"`)
+
+	_, err = ReadJSON(ctx, conn, filepath.Join(t.TempDir(), "missing.json"), JSONOptions{})
+	require.ErrorContains(t, err, "No files found")
+
+	require.NoError(t, conn.Close())
+	require.NoError(t, db.Close())
+}
+
+func TestReadFiles(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("hello"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.txt"), []byte("\x00world"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "c.bin"), []byte{0xff}, 0o644))
+
+	// BLOB contents scan into []byte values.
+	rows, err := ReadBlob(ctx, conn, filepath.Join(dir, "*"))
+	require.NoError(t, err)
+	blobs := map[string][]byte{}
+	for rows.Next() {
+		var filename string
+		var content []byte
+		require.NoError(t, rows.Scan(&filename, &content))
+		blobs[filepath.Base(filename)] = content
+	}
+	require.NoError(t, rows.Err())
+	require.NoError(t, rows.Close())
+	require.Equal(t, map[string][]byte{"a.txt": []byte("hello"), "b.txt": []byte("\x00world"), "c.bin": {0xff}}, blobs)
+
+	// Text contents scan into string values.
+	rows, err = ReadText(ctx, conn, filepath.Join(dir, "*.txt"))
+	require.NoError(t, err)
+	texts := map[string]string{}
+	for rows.Next() {
+		var filename, content string
+		require.NoError(t, rows.Scan(&filename, &content))
+		texts[filepath.Base(filename)] = content
+	}
+	require.NoError(t, rows.Err())
+	require.NoError(t, rows.Close())
+	require.Equal(t, map[string]string{"a.txt": "hello", "b.txt": "\x00world"}, texts)
+
+	require.NoError(t, conn.Close())
+	require.NoError(t, db.Close())
+}
+
+func TestErrReadFiles(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	require.NoError(t, err)
+
+	glob := filepath.Join(t.TempDir(), "*.txt")
+	_, err = ReadBlob(ctx, conn, glob)
+	testError(t, err, errAPI.Error(), invalidInputErrMsg, "a pattern matching at least one file", glob)
+	_, err = ReadText(ctx, conn, glob)
+	testError(t, err, errAPI.Error(), invalidInputErrMsg, "a pattern matching at least one file", glob)
+
+	require.NoError(t, conn.Close())
+	require.NoError(t, db.Close())
+}
+
+func TestExportParquet(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	require.NoError(t, err)
+
+	// The path contains a quote.
+	dir := t.TempDir()
+	path := filepath.Join(dir, "it's.parquet")
+	err = conn.Raw(func(driverConn any) error {
+		c := driverConn.(*Conn)
+		written, err := c.ExportParquet(ctx, `SELECT range AS id, range::VARCHAR AS name FROM range(10240);`, path, ParquetOptions{
+			Compression:  "ZSTD",
+			RowGroupSize: 2048,
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(10240), written)
+		return nil
+	})
+	require.NoError(t, err)
+
+	var count, rowGroups int
+	var compression string
+	require.NoError(t, conn.QueryRowContext(ctx, `SELECT COUNT(*) FROM read_parquet(?)`, path).Scan(&count))
+	require.Equal(t, 10240, count)
+	require.NoError(t, conn.QueryRowContext(ctx, `SELECT COUNT(DISTINCT row_group_id), ANY_VALUE(compression)
+		FROM parquet_metadata(?)`, path).Scan(&rowGroups, &compression))
+	require.Equal(t, 5, rowGroups)
+	require.Equal(t, "ZSTD", compression)
+
+	err = conn.Raw(func(driverConn any) error {
+		c := driverConn.(*Conn)
+
+		// Export with the default options.
+		written, err := c.ExportParquet(ctx, `SELECT 42 AS answer`, filepath.Join(dir, "default.parquet"), ParquetOptions{})
+		require.NoError(t, err)
+		require.Equal(t, int64(1), written)
+
+		// A trailing line comment does not comment out the rest of the COPY statement.
+		written, err = c.ExportParquet(ctx, "SELECT 42 AS answer -- the answer", filepath.Join(dir, "comment.parquet"), ParquetOptions{})
+		require.NoError(t, err)
+		require.Equal(t, int64(1), written)
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, conn.Close())
+	require.NoError(t, db.Close())
+}
+
+func TestErrExportParquet(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "test.parquet")
+
+	err = conn.Raw(func(driverConn any) error {
+		c := driverConn.(*Conn)
+		_, err := c.ExportParquet(ctx, `SELECT 42`, path, ParquetOptions{Compression: "zstd) TO 'other.parquet' (FORMAT CSV"})
+		testError(t, err, errAPI.Error(), invalidInputErrMsg, "zstd")
+		_, err = c.ExportParquet(ctx, `SELECT 42`, path, ParquetOptions{RowGroupSize: -1})
+		testError(t, err, errAPI.Error(), invalidInputErrMsg, "-1")
+		_, err = c.ExportParquet(ctx, `SELECT * FROM missing`, path, ParquetOptions{})
+		require.ErrorContains(t, err, "missing")
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, conn.Close())
+	require.NoError(t, db.Close())
+}
+
+func TestCopyFrom(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	require.NoError(t, err)
+	createTable(db, t, `CREATE TABLE users (id INTEGER, name VARCHAR)`)
+
+	dir := t.TempDir()
+	csvPath := filepath.Join(dir, "users.txt")
+	require.NoError(t, os.WriteFile(csvPath, []byte("1;alice\n2;NA\n"), 0o600))
+	parquetPath := filepath.Join(dir, "it's.parquet")
+	jsonPath := filepath.Join(dir, "users.json")
+	require.NoError(t, os.WriteFile(jsonPath, []byte(`{"id": 10, "name": "json"}`+"\n"), 0o600))
+
+	header := false
+	err = conn.Raw(func(driverConn any) error {
+		c := driverConn.(*Conn)
+		_, err := c.ExportParquet(ctx, `SELECT range::INTEGER AS id, 'p' || range AS name FROM range(3)`, parquetPath, ParquetOptions{})
+		require.NoError(t, err)
+
+		imported, err := c.CopyFrom(ctx, "users", csvPath, CopyOptions{Delimiter: ";", Header: &header, NullStr: "NA"})
+		require.NoError(t, err)
+		require.Equal(t, int64(2), imported)
+
+		// Detect the Parquet and JSON formats from the file extensions.
+		imported, err = c.CopyFrom(ctx, "users", parquetPath, CopyOptions{})
+		require.NoError(t, err)
+		require.Equal(t, int64(3), imported)
+		imported, err = c.CopyFrom(ctx, "users", jsonPath, CopyOptions{})
+		require.NoError(t, err)
+		require.Equal(t, int64(1), imported)
+		return nil
+	})
+	require.NoError(t, err)
+
+	var count, nulls int
+	require.NoError(t, conn.QueryRowContext(ctx, `SELECT count(*), count(*) FILTER (name IS NULL) FROM users`).Scan(&count, &nulls))
+	require.Equal(t, 6, count)
+	require.Equal(t, 1, nulls)
+
+	require.NoError(t, conn.Close())
+	require.NoError(t, db.Close())
+}
+
+func TestErrCopyFrom(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	require.NoError(t, err)
+	createTable(db, t, `CREATE TABLE users (id INTEGER, name VARCHAR)`)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "users.csv")
+	require.NoError(t, os.WriteFile(path, []byte("id,name\n1,alice\n"), 0o600))
+	invalid := filepath.Join(dir, "invalid.parquet")
+	require.NoError(t, os.WriteFile(invalid, []byte("id,name\n1,alice\n"), 0o600))
+
+	err = conn.Raw(func(driverConn any) error {
+		c := driverConn.(*Conn)
+		_, err := c.CopyFrom(ctx, "", path, CopyOptions{})
+		testError(t, err, errAPI.Error(), errEmptyName.Error())
+		_, err = c.CopyFrom(ctx, "users", path, CopyOptions{Format: "xlsx"})
+		testError(t, err, errAPI.Error(), invalidInputErrMsg, "xlsx")
+		_, err = c.CopyFrom(ctx, "users", invalid, CopyOptions{Delimiter: ";"})
+		testError(t, err, errAPI.Error(), invalidInputErrMsg, "Delimiter")
+		_, err = c.CopyFrom(ctx, "missing", path, CopyOptions{})
+		require.ErrorContains(t, err, "missing")
+		_, err = c.CopyFrom(ctx, "users", invalid, CopyOptions{})
+		require.ErrorContains(t, err, "invalid.parquet")
+		_, err = c.CopyFrom(ctx, "users", filepath.Join(dir, "none.csv"), CopyOptions{})
+		require.ErrorContains(t, err, "none.csv")
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, conn.Close())
+	require.NoError(t, db.Close())
+}
@@ -0,0 +1,142 @@
+package duckdb
+
+/*
+#include <duckdb.h>
+
+void map_value_bind(duckdb_bind_info info);
+void map_value_init(duckdb_init_info info);
+void map_value_callback(duckdb_function_info, duckdb_data_chunk);
+
+// See https://golang.org/issue/19835.
+typedef void (*map_value_init_t)(duckdb_init_info);
+typedef void (*map_value_bind_t)(duckdb_bind_info);
+typedef void (*map_value_callback_t)(duckdb_function_info, duckdb_data_chunk);
+*/
+import "C"
+
+import (
+	"sync"
+	"unsafe"
+)
+
+// The C API cannot create MAP values. Instead, map_from_entries converts a LIST of STRUCT(key, value)
+// entries to a MAP, and the internal mapValueFunction table function captures the MAP argument while
+// binding. Its second argument identifies the capturing call.
+const (
+	mapValueFunction = "__go_duckdb_map_value"
+	mapValueQuery    = "SELECT * FROM " + mapValueFunction + "(map_from_entries(?), ?::UBIGINT)"
+)
+
+var (
+	// mapValuesLock protects mapValues and mapValueID.
+	mapValuesLock sync.Mutex
+	// mapValues contains the captured MAP values of all pending mapValue calls.
+	mapValues  = map[uint64]*C.duckdb_value{}
+	mapValueID uint64
+)
+
+// registerMapValueFunction registers the table function capturing MAP values in the database.
+func registerMapValueFunction(db C.duckdb_database) error {
+	var con C.duckdb_connection
+	if state := C.duckdb_connect(db, &con); state == C.DuckDBError {
+		return errConnect
+	}
+	defer C.duckdb_disconnect(&con)
+
+	function := C.duckdb_create_table_function()
+	defer C.duckdb_destroy_table_function(&function)
+
+	name := C.CString(mapValueFunction)
+	defer C.duckdb_free(unsafe.Pointer(name))
+	C.duckdb_table_function_set_name(function, name)
+
+	C.duckdb_table_function_set_bind(function, C.map_value_bind_t(C.map_value_bind))
+	C.duckdb_table_function_set_init(function, C.map_value_init_t(C.map_value_init))
+	C.duckdb_table_function_set_function(function, C.map_value_callback_t(C.map_value_callback))
+
+	anyType := C.duckdb_create_logical_type(C.DUCKDB_TYPE_ANY)
+	C.duckdb_table_function_add_parameter(function, anyType)
+	C.duckdb_destroy_logical_type(&anyType)
+
+	idType := C.duckdb_create_logical_type(C.DUCKDB_TYPE_UBIGINT)
+	C.duckdb_table_function_add_parameter(function, idType)
+	C.duckdb_destroy_logical_type(&idType)
+
+	if state := C.duckdb_register_table_function(con, function); state == C.DuckDBError {
+		return errMapValue
+	}
+	return nil
+}
+
+// mapValue converts a LIST value of STRUCT(key, value) entries to a MAP value.
+// The caller must destroy the returned value.
+func (c *Conn) mapValue(entries C.duckdb_value) (C.duckdb_value, error) {
+	var val C.duckdb_value
+
+	mapValuesLock.Lock()
+	mapValueID++
+	id := mapValueID
+	mapValues[id] = &val
+	mapValuesLock.Unlock()
+
+	defer func() {
+		mapValuesLock.Lock()
+		delete(mapValues, id)
+		mapValuesLock.Unlock()
+	}()
+
+	query := C.CString(mapValueQuery)
+	defer C.duckdb_free(unsafe.Pointer(query))
+
+	var stmt C.duckdb_prepared_statement
+	defer C.duckdb_destroy_prepare(&stmt)
+	if state := C.duckdb_prepare(c.duckdbCon, query, &stmt); state == C.DuckDBError {
+		return nil, getDuckDBError(C.GoString(C.duckdb_prepare_error(stmt)))
+	}
+
+	C.duckdb_bind_value(stmt, 1, entries)
+	C.duckdb_bind_uint64(stmt, 2, C.uint64_t(id))
+
+	var res C.duckdb_result
+	defer C.duckdb_destroy_result(&res)
+	if state := C.duckdb_execute_prepared(stmt, &res); state == C.DuckDBError {
+		return nil, getDuckDBError(C.GoString(C.duckdb_result_error(&res)))
+	}
+
+	mapValuesLock.Lock()
+	defer mapValuesLock.Unlock()
+	if val == nil {
+		return nil, errMapValue
+	}
+	return val, nil
+}
+
+//export map_value_bind
+func map_value_bind(info C.duckdb_bind_info) {
+	idValue := C.duckdb_bind_get_parameter(info, 1)
+	id := uint64(C.duckdb_get_uint64(idValue))
+	C.duckdb_destroy_value(&idValue)
+
+	mapValuesLock.Lock()
+	if val, ok := mapValues[id]; ok {
+		if *val != nil {
+			C.duckdb_destroy_value(val)
+		}
+		*val = C.duckdb_bind_get_parameter(info, 0)
+	}
+	mapValuesLock.Unlock()
+
+	name := C.CString("captured")
+	defer C.duckdb_free(unsafe.Pointer(name))
+	logicalType := C.duckdb_create_logical_type(C.DUCKDB_TYPE_BOOLEAN)
+	defer C.duckdb_destroy_logical_type(&logicalType)
+	C.duckdb_bind_add_result_column(info, name, logicalType)
+}
+
+//export map_value_init
+func map_value_init(C.duckdb_init_info) {}
+
+//export map_value_callback
+func map_value_callback(_ C.duckdb_function_info, output C.duckdb_data_chunk) {
+	C.duckdb_data_chunk_set_size(output, 0)
+}
@@ -0,0 +1,143 @@
+package duckdb
+
+/*
+#include <duckdb.h>
+*/
+import "C"
+
+import (
+	"context"
+	"database/sql/driver"
+)
+
+// PendingResult is a query that executes incrementally, e.g., to keep a UI responsive while executing a query
+// on the same goroutine. Poll executes the query step by step, and Result returns the rows of the query.
+// This is an advanced API. Most users should execute queries with QueryContext, which a context can cancel.
+// A PendingResult is not safe for concurrent use. Close releases it, unless Result returned its rows.
+type PendingResult struct {
+	stmt    *Stmt
+	pending C.duckdb_pending_result
+	ctx     context.Context
+	cancel  context.CancelFunc
+	// done is true, once the query finished executing, or failed with err.
+	done   bool
+	err    error
+	closed bool
+}
+
+// QueryProgress holds the execution progress of a query.
+type QueryProgress struct {
+	// Percentage is the executed fraction of the query, between 0 and 100, or -1, if DuckDB cannot estimate it.
+	Percentage float64
+	// RowsProcessed is the number of rows processed so far.
+	RowsProcessed uint64
+	// TotalRowsToProcess is the estimated number of rows the query processes.
+	TotalRowsToProcess uint64
+}
+
+// StartQuery prepares the query on this connection and starts executing it, without executing any steps.
+// The query must not have parameters. Once ctx is done, Poll and Result return its error.
+func (c *Conn) StartQuery(ctx context.Context, query string) (*PendingResult, error) {
+	if c.closed {
+		return nil, errClosedCon
+	}
+	s, err := c.prepareStmts(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &PendingResult{stmt: s}
+	if C.duckdb_pending_prepared(*s.stmt, &p.pending) == C.DuckDBError {
+		err = getDuckDBError(C.GoString(C.duckdb_pending_error(p.pending)))
+		C.duckdb_destroy_pending(&p.pending)
+		_ = s.Close()
+		return nil, err
+	}
+	p.ctx, p.cancel = c.queryContext(ctx)
+	return p, nil
+}
+
+// Poll executes a step of the query. It returns true, once the query finished executing, or if it failed.
+// Poll returns the error of a failed query, or the error of the context, if it is done.
+func (p *PendingResult) Poll() (bool, error) {
+	if p.closed {
+		return true, getError(errAPI, errClosedPending)
+	}
+	if p.done {
+		return true, p.err
+	}
+	if err := p.ctx.Err(); err != nil {
+		p.done, p.err = true, err
+		return true, err
+	}
+
+	switch C.duckdb_pending_execute_task(p.pending) {
+	case C.DUCKDB_PENDING_RESULT_READY:
+		p.done = true
+	case C.DUCKDB_PENDING_ERROR:
+		p.done = true
+		p.err = getDuckDBError(C.GoString(C.duckdb_pending_error(p.pending)))
+	}
+	return p.done, p.err
+}
+
+// Progress returns the execution progress of the query. DuckDB only tracks the progress,
+// if the setting enable_progress_bar is true, and after progress_bar_time milliseconds.
+func (p *PendingResult) Progress() QueryProgress {
+	if p.closed {
+		return QueryProgress{Percentage: -1}
+	}
+	progress := C.duckdb_query_progress(p.stmt.c.duckdbCon)
+	return QueryProgress{
+		Percentage:         float64(progress.percentage),
+		RowsProcessed:      uint64(progress.rows_processed),
+		TotalRowsToProcess: uint64(progress.total_rows_to_process),
+	}
+}
+
+// Result returns the rows of the query. If the query did not finish executing, then Result executes
+// its remaining steps, which ctx can interrupt. Closing the rows closes the PendingResult.
+func (p *PendingResult) Result() (driver.Rows, error) {
+	if p.closed {
+		return nil, getError(errAPI, errClosedPending)
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	var res C.duckdb_result
+	var state C.duckdb_state
+	p.stmt.run(p.ctx, func() {
+		state = C.duckdb_execute_pending(p.pending, &res)
+	})
+	if state == C.DuckDBError {
+		if err := p.ctx.Err(); err != nil {
+			p.err = err
+		} else {
+			p.err = getDuckDBError(C.GoString(C.duckdb_result_error(&res)))
+		}
+		p.done = true
+		C.duckdb_destroy_result(&res)
+		return nil, p.err
+	}
+
+	// The rows close the statement.
+	C.duckdb_destroy_pending(&p.pending)
+	p.cancel()
+	p.closed = true
+	p.stmt.rows = true
+	p.stmt.closeOnRowsClose = true
+	return newRowsWithStmt(res, p.stmt), nil
+}
+
+// Close stops executing the query and releases the PendingResult.
+// It is a no-op after Result returned the rows of the query.
+func (p *PendingResult) Close() error {
+	if p.closed {
+		return nil
+	}
+	p.closed = true
+	C.duckdb_destroy_pending(&p.pending)
+	p.cancel()
+	return p.stmt.Close()
+}
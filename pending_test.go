@@ -0,0 +1,99 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql/driver"
+	"io"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPendingResult(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	require.NoError(t, err)
+
+	err = conn.Raw(func(driverConn any) error {
+		c := driverConn.(*Conn)
+		_, err := c.ExecContext(ctx, `SET enable_progress_bar = true; SET enable_progress_bar_print = false;
+			SET progress_bar_time = 0; CREATE TABLE big AS SELECT range AS v FROM range(10000000)`, nil)
+		require.NoError(t, err)
+
+		// Poll the query until it finishes, and track its progress.
+		p, err := c.StartQuery(ctx, `SELECT sum(v) FROM big`)
+		require.NoError(t, err)
+		var maxPercentage float64
+		for {
+			done, err := p.Poll()
+			require.NoError(t, err)
+			if done {
+				break
+			}
+			progress := p.Progress()
+			require.GreaterOrEqual(t, progress.Percentage, maxPercentage)
+			maxPercentage = progress.Percentage
+		}
+		require.Greater(t, maxPercentage, float64(0))
+
+		r, err := p.Result()
+		require.NoError(t, err)
+		values := make([]driver.Value, 1)
+		require.NoError(t, r.Next(values))
+		require.Equal(t, big.NewInt(49999995000000), values[0])
+		require.Equal(t, io.EOF, r.Next(values))
+		require.NoError(t, r.Close())
+		require.NoError(t, p.Close())
+		_, err = p.Poll()
+		testError(t, err, errAPI.Error(), errClosedPending.Error())
+
+		// Result executes the remaining steps.
+		p, err = c.StartQuery(ctx, `SELECT 42`)
+		require.NoError(t, err)
+		r, err = p.Result()
+		require.NoError(t, err)
+		require.NoError(t, r.Next(values))
+		require.Equal(t, int32(42), values[0])
+		require.NoError(t, r.Close())
+
+		// Poll returns the error of a failed query.
+		p, err = c.StartQuery(ctx, `SELECT error('boom') FROM big`)
+		require.NoError(t, err)
+		var done bool
+		for !done {
+			done, err = p.Poll()
+		}
+		require.ErrorContains(t, err, "boom")
+		_, err = p.Result()
+		require.ErrorContains(t, err, "boom")
+		require.NoError(t, p.Close())
+
+		// Poll returns the error of the context.
+		cancelCtx, cancel := context.WithCancel(ctx)
+		p, err = c.StartQuery(cancelCtx, `SELECT sum(v) FROM big`)
+		require.NoError(t, err)
+		_, err = p.Poll()
+		require.NoError(t, err)
+		cancel()
+		done, err = p.Poll()
+		require.True(t, done)
+		require.ErrorIs(t, err, context.Canceled)
+		require.NoError(t, p.Close())
+
+		// Closing a pending result without polling it releases the statement.
+		p, err = c.StartQuery(ctx, `SELECT sum(v) FROM big`)
+		require.NoError(t, err)
+		require.NoError(t, p.Close())
+		require.NoError(t, p.Close())
+
+		_, err = c.StartQuery(ctx, `SELECT * FROM not_exist`)
+		require.ErrorContains(t, err, "not_exist")
+		return nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+	require.NoError(t, db.Close())
+}
@@ -6,7 +6,13 @@ package duckdb
 import "C"
 
 import (
+	"context"
 	"database/sql"
+	"database/sql/driver"
+	"io"
+	"strconv"
+	"strings"
+	"time"
 	"unsafe"
 )
 
@@ -17,28 +23,143 @@ import (
 type ProfilingInfo struct {
 	// Metrics contains all key-value pairs of the current node.
 	// The key represents the name and corresponds to the measured value.
-	Metrics map[string]string
+	Metrics map[string]string `json:"metrics"`
 	// Children contains all children of the node and their respective metrics.
-	Children []ProfilingInfo
+	Children []ProfilingInfo `json:"children,omitempty"`
 }
 
+// ProfilingNode is a node of a query plan, as returned by Conn.Profile.
+// It is a typed view of the most common metrics of a ProfilingInfo node, and it is JSON-serializable.
+type ProfilingNode struct {
+	// OperatorType is the name of the operator, e.g., HASH_GROUP_BY, or QUERY_ROOT for the top-level node.
+	OperatorType string `json:"operator_type"`
+	// Timing is the time spent in the operator, or the latency of the entire query for the QUERY_ROOT.
+	// It serializes to JSON as nanoseconds.
+	Timing time.Duration `json:"timing"`
+	// Cardinality is the number of rows produced by the operator, or returned by the query for the QUERY_ROOT.
+	Cardinality uint64 `json:"cardinality"`
+	// Metrics contains all key-value pairs of the node.
+	Metrics map[string]string `json:"metrics"`
+	// Children contains the child operators of the node.
+	Children []ProfilingNode `json:"children,omitempty"`
+}
+
+const profilingQueryRoot = "QUERY_ROOT"
+
 // GetProfilingInfo obtains all available metrics set by the current connection.
 func GetProfilingInfo(c *sql.Conn) (ProfilingInfo, error) {
-	info := ProfilingInfo{}
+	var info ProfilingInfo
 	err := c.Raw(func(driverConn any) error {
-		con := driverConn.(*Conn)
-		duckdbInfo := C.duckdb_get_profiling_info(con.duckdbCon)
-		if duckdbInfo == nil {
-			return getError(errProfilingInfoEmpty, nil)
-		}
-
-		// Recursive tree traversal.
-		info.getMetrics(duckdbInfo)
-		return nil
+		var err error
+		info, err = driverConn.(*Conn).profilingInfo()
+		return err
 	})
 	return info, err
 }
 
+func (c *Conn) profilingInfo() (ProfilingInfo, error) {
+	info := ProfilingInfo{}
+	duckdbInfo := C.duckdb_get_profiling_info(c.duckdbCon)
+	if duckdbInfo == nil {
+		return info, getError(errProfilingInfoEmpty, nil)
+	}
+
+	// Recursive tree traversal.
+	info.getMetrics(duckdbInfo)
+	return info, nil
+}
+
+// Profile executes the query with profiling enabled on the connection, and returns its query plan
+// including each operator's metrics. It binds the query to args, and consumes and discards all result rows.
+// Profile disables profiling on the connection before returning.
+func (c *Conn) Profile(ctx context.Context, query string, args ...any) (ProfilingNode, error) {
+	if _, err := c.ExecContext(ctx, `PRAGMA enable_profiling = 'no_output'`, nil); err != nil {
+		return ProfilingNode{}, err
+	}
+	defer c.ExecContext(context.Background(), `PRAGMA disable_profiling`, nil)
+
+	rows, err := c.QueryContext(ctx, query, namedValues(args))
+	if err != nil {
+		return ProfilingNode{}, err
+	}
+	values := make([]driver.Value, len(rows.Columns()))
+	for err == nil {
+		err = rows.Next(values)
+	}
+	if err != io.EOF {
+		rows.Close()
+		return ProfilingNode{}, err
+	}
+	if err = rows.Close(); err != nil {
+		return ProfilingNode{}, err
+	}
+
+	info, err := c.profilingInfo()
+	if err != nil {
+		return ProfilingNode{}, err
+	}
+	return info.node(true), nil
+}
+
+// Explain returns the text of the physical query plan of the query, which it binds to args.
+// If analyze is true, then Explain executes the query, and the plan contains each operator's
+// number of rows and timing.
+func (c *Conn) Explain(ctx context.Context, query string, analyze bool, args ...any) (string, error) {
+	prefix := "EXPLAIN "
+	if analyze {
+		prefix = "EXPLAIN ANALYZE "
+	}
+
+	// Each row contains the kind of the plan, and its text.
+	var plans []string
+	err := c.queryValues(ctx, prefix+query, namedValues(args), 2, func(values []driver.Value) {
+		plan, _ := values[1].(string)
+		plans = append(plans, plan)
+	})
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(plans, "\n"), nil
+}
+
+// namedValues converts the arguments of a query to driver.NamedValue arguments.
+// An sql.NamedArg argument binds to the parameter of its name.
+func namedValues(args []any) []driver.NamedValue {
+	namedArgs := make([]driver.NamedValue, len(args))
+	for i, arg := range args {
+		namedArgs[i] = driver.NamedValue{Ordinal: i + 1, Value: arg}
+		if named, ok := arg.(sql.NamedArg); ok {
+			namedArgs[i].Name, namedArgs[i].Value = named.Name, named.Value
+		}
+	}
+	return namedArgs
+}
+
+func (info *ProfilingInfo) node(root bool) ProfilingNode {
+	n := ProfilingNode{Metrics: info.Metrics}
+
+	timingKey, cardinalityKey := "OPERATOR_TIMING", "OPERATOR_CARDINALITY"
+	if root {
+		n.OperatorType = profilingQueryRoot
+		timingKey, cardinalityKey = "LATENCY", "ROWS_RETURNED"
+	} else {
+		n.OperatorType = info.Metrics["OPERATOR_TYPE"]
+	}
+
+	// Metrics are optional, so we ignore missing or unparsable values.
+	if seconds, err := strconv.ParseFloat(info.Metrics[timingKey], 64); err == nil {
+		n.Timing = time.Duration(seconds * float64(time.Second))
+	}
+	if cardinality, err := strconv.ParseUint(info.Metrics[cardinalityKey], 10, 64); err == nil {
+		n.Cardinality = cardinality
+	}
+
+	for i := range info.Children {
+		n.Children = append(n.Children, info.Children[i].node(false))
+	}
+	return n
+}
+
 func (info *ProfilingInfo) getMetrics(duckdbInfo C.duckdb_profiling_info) {
 	m := C.duckdb_profiling_info_get_metrics(duckdbInfo)
 	count := C.duckdb_get_map_size(m)
@@ -3,6 +3,7 @@ package duckdb
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -38,6 +39,90 @@ func TestProfiling(t *testing.T) {
 	require.NotEmpty(t, info.Children[0].Metrics, "child metrics must not be empty")
 }
 
+func TestProfile(t *testing.T) {
+	t.Parallel()
+
+	db, err := sql.Open("duckdb", "")
+	require.NoError(t, err)
+	con, err := db.Conn(context.Background())
+	require.NoError(t, err)
+
+	var root ProfilingNode
+	err = con.Raw(func(driverConn any) error {
+		root, err = driverConn.(*Conn).Profile(context.Background(), `
+			SELECT range % 3 AS g, COUNT(*) FROM range(?) GROUP BY g`, 100)
+		return err
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, profilingQueryRoot, root.OperatorType)
+	require.Equal(t, uint64(3), root.Cardinality)
+	require.Positive(t, root.Timing)
+	require.NotEmpty(t, root.Children)
+
+	groupBy := root.Children[0]
+	require.Equal(t, "HASH_GROUP_BY", groupBy.OperatorType)
+	require.Equal(t, uint64(3), groupBy.Cardinality)
+	require.NotEmpty(t, groupBy.Metrics)
+
+	// The node is JSON-serializable.
+	data, err := json.Marshal(root)
+	require.NoError(t, err)
+	var decoded ProfilingNode
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, root, decoded)
+
+	// Profile disables profiling afterward.
+	res, err := con.QueryContext(context.Background(), `SELECT 42`)
+	require.NoError(t, err)
+	require.NoError(t, res.Close())
+	_, err = GetProfilingInfo(con)
+	testError(t, err, errProfilingInfoEmpty.Error())
+
+	err = con.Raw(func(driverConn any) error {
+		_, err := driverConn.(*Conn).Profile(context.Background(), `SELECT * FROM does_not_exist`)
+		return err
+	})
+	require.ErrorContains(t, err, "does_not_exist")
+
+	require.NoError(t, con.Close())
+	require.NoError(t, db.Close())
+}
+
+func TestExplain(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	createTable(db, t, `CREATE TABLE users (id INTEGER, name VARCHAR)`)
+	_, err := db.Exec(`INSERT INTO users SELECT range, 'user' || range FROM range(100)`)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	con, err := db.Conn(ctx)
+	require.NoError(t, err)
+
+	err = con.Raw(func(driverConn any) error {
+		c := driverConn.(*Conn)
+		plan, err := c.Explain(ctx, `SELECT name, count(*) FROM users WHERE id > ? GROUP BY name`, false, 10)
+		require.NoError(t, err)
+		require.Contains(t, plan, "HASH_GROUP_BY")
+		require.NotContains(t, plan, "Total Time")
+
+		// EXPLAIN ANALYZE executes the query.
+		plan, err = c.Explain(ctx, `SELECT name FROM users WHERE id > $min`, true, sql.Named("min", 90))
+		require.NoError(t, err)
+		require.Contains(t, plan, "Total Time")
+		require.Contains(t, plan, "9 Rows")
+
+		_, err = c.Explain(ctx, `SELECT * FROM missing`, false)
+		require.ErrorContains(t, err, "missing")
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, con.Close())
+	require.NoError(t, db.Close())
+}
+
 func TestErrProfiling(t *testing.T) {
 	t.Parallel()
 	db, err := sql.Open("duckdb", "")
@@ -9,12 +9,21 @@ package duckdb
 import "C"
 
 import (
+	"fmt"
 	"runtime/cgo"
 	"unsafe"
 )
 
+// ReplacementScanCallback resolves a table name, which is not a table, view, or CTE of the catalog.
+// It returns the name of a table function replacing the table, e.g., read_parquet, and the function's parameters.
+// The parameters must be of type string, bool, int, int8 to int64, uint, uint8 to uint64, float32, or float64.
+// If the callback returns an empty function name, then DuckDB resolves the table name as if there was no callback.
+// If the callback returns an error, then the query fails with that error.
 type ReplacementScanCallback func(tableName string) (string, []any, error)
 
+// RegisterReplacementScan registers a replacement scan callback on all connections of the connector.
+// E.g., given a callback replacing "my_table" with the table function call read_parquet('my_table.parquet'),
+// the query SELECT * FROM my_table reads the Parquet file.
 func RegisterReplacementScan(connector *Connector, cb ReplacementScanCallback) {
 	handle := cgo.NewHandle(cb)
 	C.duckdb_add_replacement_scan(connector.db, C.duckdb_replacement_callback_t(C.replacement_scan_cb), unsafe.Pointer(&handle), C.duckdb_delete_callback_t(C.replacement_scan_destroy_data))
@@ -38,27 +47,60 @@ func replacement_scan_cb(info C.duckdb_replacement_scan_info, table_name *C.ccha
 		return
 	}
 
+	// Keep resolving the table name without a replacement.
+	if tFunc == "" {
+		return
+	}
+
 	fNameStr := C.CString(tFunc)
 	C.duckdb_replacement_scan_set_function_name(info, fNameStr)
 	defer C.duckdb_free(unsafe.Pointer(fNameStr))
 
-	for _, v := range params {
-		switch x := v.(type) {
-		case string:
-			str := C.CString(x)
-			val := C.duckdb_create_varchar(str)
-			C.duckdb_replacement_scan_add_parameter(info, val)
-			C.duckdb_free(unsafe.Pointer(str))
-			C.duckdb_destroy_value(&val)
-		case int64:
-			val := C.duckdb_create_int64(C.int64_t(x))
-			C.duckdb_replacement_scan_add_parameter(info, val)
-			C.duckdb_destroy_value(&val)
-		default:
-			errStr := C.CString("invalid type")
+	for i, v := range params {
+		val, err := replacementScanValue(v)
+		if err != nil {
+			errStr := C.CString(getError(errAPI, addIndexToError(err, i)).Error())
 			C.duckdb_replacement_scan_set_error(info, errStr)
 			C.duckdb_free(unsafe.Pointer(errStr))
 			return
 		}
+		C.duckdb_replacement_scan_add_parameter(info, val)
+		C.duckdb_destroy_value(&val)
+	}
+}
+
+func replacementScanValue(v any) (C.duckdb_value, error) {
+	switch x := v.(type) {
+	case string:
+		str := C.CString(x)
+		defer C.duckdb_free(unsafe.Pointer(str))
+		return C.duckdb_create_varchar_length(str, C.idx_t(len(x))), nil
+	case bool:
+		return C.duckdb_create_bool(C.bool(x)), nil
+	case int8:
+		return C.duckdb_create_int8(C.int8_t(x)), nil
+	case int16:
+		return C.duckdb_create_int16(C.int16_t(x)), nil
+	case int32:
+		return C.duckdb_create_int32(C.int32_t(x)), nil
+	case int64:
+		return C.duckdb_create_int64(C.int64_t(x)), nil
+	case int:
+		return C.duckdb_create_int64(C.int64_t(x)), nil
+	case uint8:
+		return C.duckdb_create_uint8(C.uint8_t(x)), nil
+	case uint16:
+		return C.duckdb_create_uint16(C.uint16_t(x)), nil
+	case uint32:
+		return C.duckdb_create_uint32(C.uint32_t(x)), nil
+	case uint64:
+		return C.duckdb_create_uint64(C.uint64_t(x)), nil
+	case uint:
+		return C.duckdb_create_uint64(C.uint64_t(x)), nil
+	case float32:
+		return C.duckdb_create_float(C.float(x)), nil
+	case float64:
+		return C.duckdb_create_double(C.double(x)), nil
 	}
+	return nil, unsupportedTypeError(fmt.Sprintf("%T", v))
 }
@@ -41,3 +41,45 @@ func TestReplacementScan(t *testing.T) {
 		require.Fail(t, "expected 0, got %d", rangeRows)
 	}
 }
+
+func TestReplacementScanParameters(t *testing.T) {
+	connector, err := NewConnector("", nil)
+	require.NoError(t, err)
+	defer connector.Close()
+
+	RegisterReplacementScan(connector, func(tableName string) (string, []any, error) {
+		switch tableName {
+		case "numbers":
+			return "range", []any{int32(2), uint16(12), 4}, nil
+		case "alias":
+			return "query_table", []any{"catalog_table"}, nil
+		case "invalid":
+			return "range", []any{[]int{1}}, nil
+		}
+		// Keep resolving other table names.
+		return "", nil, nil
+	})
+
+	db := sql.OpenDB(connector)
+	defer db.Close()
+
+	var sum, count int64
+	require.NoError(t, db.QueryRow(`SELECT SUM(range), COUNT(*) FROM numbers`).Scan(&sum, &count))
+	require.Equal(t, int64(2+6+10), sum)
+	require.Equal(t, int64(3), count)
+
+	// Catalog tables take precedence, and unresolved names fail as usual.
+	_, err = db.Exec(`CREATE TABLE catalog_table AS SELECT 42 AS v`)
+	require.NoError(t, err)
+	var v int32
+	require.NoError(t, db.QueryRow(`SELECT v FROM catalog_table`).Scan(&v))
+	require.Equal(t, int32(42), v)
+	require.NoError(t, db.QueryRow(`SELECT v FROM alias`).Scan(&v))
+	require.Equal(t, int32(42), v)
+
+	_, err = db.Query(`SELECT * FROM unknown_table`)
+	require.ErrorContains(t, err, "unknown_table")
+
+	_, err = db.Query(`SELECT * FROM invalid`)
+	require.ErrorContains(t, err, unsupportedTypeErrMsg)
+}
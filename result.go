@@ -1,13 +1,36 @@
 package duckdb
 
+/*
+#include <duckdb.h>
+*/
+import "C"
+
 type result struct {
-	rowsAffected int64
+	rowsAffected  int64
+	statementType StatementType
+}
+
+func newResult(res *C.duckdb_result) *result {
+	return &result{
+		rowsAffected:  int64(C.duckdb_rows_changed(res)),
+		statementType: StatementType(C.duckdb_result_statement_type(*res)),
+	}
 }
 
+// LastInsertId returns an error, as DuckDB does not return the row IDs of inserted rows.
+// Use a RETURNING clause to obtain the inserted values, e.g., of a column with a sequence default.
 func (r result) LastInsertId() (int64, error) {
-	return 0, nil
+	return 0, getError(errAPI, errLastInsertIDNotSupported)
 }
 
+// RowsAffected returns the number of rows changed by an INSERT, UPDATE, or DELETE statement, and zero otherwise.
+// Statements with a RETURNING clause return rows instead, so their RowsAffected is zero.
 func (r result) RowsAffected() (int64, error) {
 	return r.rowsAffected, nil
 }
+
+// StatementType returns the type of the executed statement, e.g., STATEMENT_TYPE_INSERT.
+// For multiple statements, it returns the type of the last statement.
+func (r result) StatementType() StatementType {
+	return r.statementType
+}
@@ -30,8 +30,9 @@ func SetRowValue[T any](row Row, colIdx int, val T) error {
 
 // SetRowValue sets the value at colIdx to val. Returns an error on failure.
 func (r Row) SetRowValue(colIdx int, val any) error {
-	if !r.IsProjected(colIdx) {
+	projectedIdx := r.projection[colIdx]
+	if projectedIdx < 0 {
 		return nil
 	}
-	return r.chunk.SetValue(colIdx, int(r.r), val)
+	return r.chunk.SetValue(projectedIdx, int(r.r), val)
 }
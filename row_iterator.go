@@ -0,0 +1,93 @@
+package duckdb
+
+import (
+	"database/sql/driver"
+	"sync"
+)
+
+// A RowIterator is a source of rows, which RowIteratorTableFunction turns into a table function.
+// Such a table function is also a target of a ReplacementScanCallback.
+//
+// Next advances to the next row and returns false after the last row, or on failure.
+// Values returns the values of the current row, in the order of the columns.
+// A nil value is a NULL value. The iterator may reuse the slice between calls to Next.
+// Err returns the error that stopped the iteration, if any.
+// Close releases the resources of the iterator.
+//
+// DuckDB pulls rows from the iterator when it requires them for the next chunk of the result.
+// Thus, a slow consumer of the result never causes the iterator to run ahead by more than a chunk.
+// go-duckdb calls Close exactly once, either after the last row, or when DuckDB stops requesting rows,
+// e.g., due to a LIMIT clause or a closed result.
+type RowIterator interface {
+	Next() bool
+	Values() []driver.Value
+	Err() error
+	Close()
+}
+
+// RowIteratorTableFunction returns a RowTableFunction that produces the rows of a RowIterator.
+// The config declares the arguments of the table function, and the columns declare its output columns.
+// For each query, go-duckdb calls open with the arguments of that query to obtain a new iterator.
+func RowIteratorTableFunction(config TableFunctionConfig, columns []ColumnInfo,
+	open func(named map[string]any, args ...any) (RowIterator, error),
+) RowTableFunction {
+	return RowTableFunction{
+		Config: config,
+		BindArguments: func(named map[string]any, args ...any) (RowTableSource, error) {
+			if open == nil {
+				return nil, getError(errAPI, interfaceIsNilError("open"))
+			}
+			iter, err := open(named, args...)
+			if err != nil {
+				return nil, err
+			}
+			if iter == nil {
+				return nil, getError(errAPI, interfaceIsNilError("RowIterator"))
+			}
+			return &rowIteratorSource{columns: columns, iter: iter}, nil
+		},
+	}
+}
+
+// rowIteratorSource implements a RowTableSource for a RowIterator.
+type rowIteratorSource struct {
+	columns []ColumnInfo
+	iter    RowIterator
+	once    sync.Once
+}
+
+func (s *rowIteratorSource) ColumnInfos() []ColumnInfo {
+	return s.columns
+}
+
+func (s *rowIteratorSource) Cardinality() *CardinalityInfo {
+	return nil
+}
+
+func (s *rowIteratorSource) Init() {}
+
+func (s *rowIteratorSource) FillRow(row Row) (bool, error) {
+	if !s.iter.Next() {
+		err := s.iter.Err()
+		s.close()
+		return false, err
+	}
+
+	values := s.iter.Values()
+	if len(values) != len(s.columns) {
+		s.close()
+		return false, getError(errAPI, columnCountError(len(values), len(s.columns)))
+	}
+	for i, v := range values {
+		if err := row.SetRowValue(i, v); err != nil {
+			s.close()
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// close closes the iterator once. go-duckdb also calls it when DuckDB destroys the bind data.
+func (s *rowIteratorSource) close() {
+	s.once.Do(s.iter.Close)
+}
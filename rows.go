@@ -6,11 +6,13 @@ package duckdb
 import "C"
 
 import (
+	"context"
 	"database/sql/driver"
-	"fmt"
+	"encoding/json"
 	"io"
 	"math/big"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 	"unsafe"
@@ -30,47 +32,73 @@ type rows struct {
 	chunkIdx C.idx_t
 	// rowCount is the number of scanned rows.
 	rowCount int
+	// rawColumns marks the VARCHAR columns, which return values referencing DuckDB's memory.
+	rawColumns []bool
+	// streaming is true, if res is a streaming result, which fetches each chunk from DuckDB.
+	streaming bool
+	// ctx is the query's context, which interrupts fetching the chunks of a streaming result.
+	ctx context.Context
+	// cancel releases ctx.
+	cancel context.CancelFunc
 }
 
 func newRowsWithStmt(res C.duckdb_result, stmt *Stmt) *rows {
 	columnCount := C.duckdb_column_count(&res)
 	r := rows{
-		res:        res,
-		stmt:       stmt,
-		chunk:      DataChunk{},
-		chunkCount: C.duckdb_result_chunk_count(res),
-		chunkIdx:   0,
-		rowCount:   0,
+		res:       res,
+		stmt:      stmt,
+		chunk:     DataChunk{},
+		chunkIdx:  0,
+		rowCount:  0,
+		streaming: bool(C.duckdb_result_is_streaming(res)),
+	}
+	if !r.streaming {
+		r.chunkCount = C.duckdb_result_chunk_count(res)
 	}
 
 	for i := C.idx_t(0); i < columnCount; i++ {
 		columnName := C.GoString(C.duckdb_column_name(&res, i))
 		r.chunk.columnNames = append(r.chunk.columnNames, columnName)
 	}
+
+	if stmt.c.rawText {
+		r.rawColumns = make([]bool, columnCount)
+		for i := C.idx_t(0); i < columnCount; i++ {
+			r.rawColumns[i] = isRawTextColumn(&res, i)
+		}
+	}
 	return &r
 }
 
+// StatementType returns the type of the executed statement, e.g., STATEMENT_TYPE_SELECT,
+// or STATEMENT_TYPE_INSERT for an INSERT statement with a RETURNING clause. Call it before closing the rows.
+func (r *rows) StatementType() StatementType {
+	return StatementType(C.duckdb_result_statement_type(r.res))
+}
+
 func (r *rows) Columns() []string {
 	return r.chunk.columnNames
 }
 
 func (r *rows) Next(dst []driver.Value) error {
 	for r.rowCount == r.chunk.size {
-		r.chunk.close()
-		if r.chunkIdx == r.chunkCount {
-			return io.EOF
-		}
-		data := C.duckdb_result_get_chunk(r.res, r.chunkIdx)
-		if err := r.chunk.initFromDuckDataChunk(data, false); err != nil {
-			return getError(err, nil)
+		if err := r.loadChunk(); err != nil {
+			return err
 		}
-
-		r.chunkIdx++
-		r.rowCount = 0
 	}
 
 	columnCount := len(r.chunk.columns)
 	for colIdx := 0; colIdx < columnCount; colIdx++ {
+		// The data of the chunk remains valid until the next call to Next.
+		if r.rawColumns != nil && r.rawColumns[colIdx] {
+			vec := &r.chunk.columns[colIdx]
+			dst[colIdx] = nil
+			if !vec.getNull(C.idx_t(r.rowCount)) {
+				dst[colIdx] = vec.getRawBytes(C.idx_t(r.rowCount))
+			}
+			continue
+		}
+
 		var err error
 		if dst[colIdx], err = r.chunk.GetValue(colIdx, r.rowCount); err != nil {
 			return err
@@ -81,9 +109,206 @@ func (r *rows) Next(dst []driver.Value) error {
 	return nil
 }
 
+// loadChunk replaces the current chunk with the next chunk of the result.
+func (r *rows) loadChunk() error {
+	r.chunk.close()
+	data, err := r.nextChunk()
+	if err != nil {
+		return err
+	}
+	if err = r.chunk.initFromDuckDataChunk(data, false); err != nil {
+		return getError(err, nil)
+	}
+	if r.stmt.c.rawJSON {
+		for i := range r.chunk.columns {
+			r.chunk.columns[i].setRawJSON()
+		}
+	}
+	// Return TIMESTAMPTZ values in the location of the connection.
+	if loc := r.stmt.c.location; loc != nil {
+		for i := range r.chunk.columns {
+			r.chunk.columns[i].setLocation(loc)
+		}
+	}
+
+	r.chunkIdx++
+	r.rowCount = 0
+	return nil
+}
+
+// NextChunk advances to the next chunk of the result, and returns its number of rows, or io.EOF after the last chunk.
+// It skips any rows of the current chunk, which Next did not return yet.
+// After NextChunk, Next continues with the first row of the following chunk.
+func (r *rows) NextChunk() (int, error) {
+	for {
+		if err := r.loadChunk(); err != nil {
+			return 0, err
+		}
+		if r.chunk.size != 0 {
+			break
+		}
+	}
+	r.rowCount = r.chunk.size
+	return r.chunk.size, nil
+}
+
+// NextChunk advances the driver.Rows r to the next chunk of the result, and returns its number of rows.
+// It returns io.EOF after the last chunk. See ScanColumn.
+func NextChunk(r driver.Rows) (int, error) {
+	duckdbRows, ok := r.(*rows)
+	if !ok {
+		return 0, getError(errAPI, errInvalidRows)
+	}
+	return duckdbRows.NextChunk()
+}
+
+// ScanColumn copies the values of the column at index in the current chunk into dst,
+// which must have the length of the chunk, as returned by NextChunk.
+// dst is a []bool, []int8, []int16, []int32, []int64, []uint8, []uint16, []uint32, []uint64, []float32, or []float64
+// slice, whose element type matches the column type, e.g., []int64 for a BIGINT column.
+// ScanColumn copies the column in one pass, and NULL values are zero values.
+func (r *rows) ScanColumn(index int, dst any) error {
+	if r.chunk.data == nil {
+		return getError(errAPI, errNoChunk)
+	}
+	if index < 0 || index >= len(r.chunk.columns) {
+		return getError(errAPI, columnCountError(index, len(r.chunk.columns)))
+	}
+
+	vec := &r.chunk.columns[index]
+	switch d := dst.(type) {
+	case []bool:
+		return scanPrimitiveColumn(vec, TYPE_BOOLEAN, d, r.chunk.size)
+	case []int8:
+		return scanPrimitiveColumn(vec, TYPE_TINYINT, d, r.chunk.size)
+	case []int16:
+		return scanPrimitiveColumn(vec, TYPE_SMALLINT, d, r.chunk.size)
+	case []int32:
+		return scanPrimitiveColumn(vec, TYPE_INTEGER, d, r.chunk.size)
+	case []int64:
+		return scanPrimitiveColumn(vec, TYPE_BIGINT, d, r.chunk.size)
+	case []uint8:
+		return scanPrimitiveColumn(vec, TYPE_UTINYINT, d, r.chunk.size)
+	case []uint16:
+		return scanPrimitiveColumn(vec, TYPE_USMALLINT, d, r.chunk.size)
+	case []uint32:
+		return scanPrimitiveColumn(vec, TYPE_UINTEGER, d, r.chunk.size)
+	case []uint64:
+		return scanPrimitiveColumn(vec, TYPE_UBIGINT, d, r.chunk.size)
+	case []float32:
+		return scanPrimitiveColumn(vec, TYPE_FLOAT, d, r.chunk.size)
+	case []float64:
+		return scanPrimitiveColumn(vec, TYPE_DOUBLE, d, r.chunk.size)
+	}
+	return getError(errAPI, castError(typeToStringMap[vec.Type], reflect.TypeOf(dst).String()))
+}
+
+// ScanColumn copies the values of the column at index in the current chunk of the driver.Rows r into dst.
+// r must be the driver.Rows of a query executed on a DuckDB driver connection,
+// e.g., the result of (*Conn).QueryContext.
+func ScanColumn(r driver.Rows, index int, dst any) error {
+	duckdbRows, ok := r.(*rows)
+	if !ok {
+		return getError(errAPI, errInvalidRows)
+	}
+	return duckdbRows.ScanColumn(index, dst)
+}
+
+// scanPrimitiveColumn copies the first size values of a vector of type t into dst.
+func scanPrimitiveColumn[T any](vec *vector, t Type, dst []T, size int) error {
+	if vec.Type != t {
+		return getError(errAPI, castError(typeToStringMap[vec.Type], reflect.TypeOf(dst).String()))
+	}
+	if len(dst) != size {
+		return getError(errAPI, invalidInputError(strconv.Itoa(len(dst)), "a slice of length "+strconv.Itoa(size)))
+	}
+	copy(dst, unsafe.Slice((*T)(vec.ptr), size))
+
+	// The values of NULL rows are undefined.
+	if vec.mask == nil {
+		return nil
+	}
+	var zero T
+	for i := 0; i < size; i++ {
+		if vec.getNull(C.idx_t(i)) {
+			dst[i] = zero
+		}
+	}
+	return nil
+}
+
+// nextChunk returns the next chunk of the result, or io.EOF.
+func (r *rows) nextChunk() (C.duckdb_data_chunk, error) {
+	if !r.streaming {
+		if r.chunkIdx == r.chunkCount {
+			return nil, io.EOF
+		}
+		return C.duckdb_result_get_chunk(r.res, r.chunkIdx), nil
+	}
+
+	// Fetching the chunk of a streaming result executes the query, which the context can interrupt.
+	var data C.duckdb_data_chunk
+	r.stmt.run(r.ctx, func() {
+		data = C.duckdb_fetch_chunk(r.res)
+	})
+	if data != nil {
+		return data, nil
+	}
+	if err := r.ctx.Err(); err != nil {
+		return nil, err
+	}
+	if msg := C.duckdb_result_error(&r.res); msg != nil {
+		return nil, getDuckDBError(C.GoString(msg))
+	}
+	return nil, io.EOF
+}
+
+// isRawTextColumn returns true for VARCHAR columns, excluding JSON columns, which the driver unmarshals.
+func isRawTextColumn(res *C.duckdb_result, i C.idx_t) bool {
+	logicalType := C.duckdb_column_logical_type(res, i)
+	defer C.duckdb_destroy_logical_type(&logicalType)
+	if Type(C.duckdb_get_type_id(logicalType)) != TYPE_VARCHAR {
+		return false
+	}
+	return !isJSONType(logicalType)
+}
+
+// isJSONType returns true for the JSON logical type.
+func isJSONType(logicalType C.duckdb_logical_type) bool {
+	cAlias := C.duckdb_logical_type_get_alias(logicalType)
+	defer C.duckdb_free(unsafe.Pointer(cAlias))
+	return C.GoString(cAlias) == aliasJSON
+}
+
+// isGeometryType returns true for the spatial extension's GEOMETRY logical type.
+func isGeometryType(logicalType C.duckdb_logical_type) bool {
+	cAlias := C.duckdb_logical_type_get_alias(logicalType)
+	defer C.duckdb_free(unsafe.Pointer(cAlias))
+	return C.GoString(cAlias) == aliasGeometry
+}
+
 // ColumnTypeScanType implements driver.RowsColumnTypeScanType.
+// It returns the Go type of the column's values, or the interface type for types without a Go mapping.
 func (r *rows) ColumnTypeScanType(index int) reflect.Type {
+	if r.rawColumns != nil && r.rawColumns[index] {
+		return reflect.TypeOf([]byte{})
+	}
+	if r.stmt.c.rawJSON {
+		logicalType := C.duckdb_column_logical_type(&r.res, C.idx_t(index))
+		defer C.duckdb_destroy_logical_type(&logicalType)
+		if isJSONType(logicalType) {
+			return reflect.TypeOf(json.RawMessage{})
+		}
+	}
 	t := Type(C.duckdb_column_type(&r.res, C.idx_t(index)))
+	if scanType := scanType(t); scanType != nil {
+		return scanType
+	}
+	return reflect.TypeOf((*any)(nil)).Elem()
+}
+
+// scanType returns the Go type of the values of Type t.
+func scanType(t Type) reflect.Type {
 	switch t {
 	case TYPE_INVALID:
 		return nil
@@ -113,7 +338,7 @@ func (r *rows) ColumnTypeScanType(index int) reflect.Type {
 		return reflect.TypeOf(time.Time{})
 	case TYPE_INTERVAL:
 		return reflect.TypeOf(Interval{})
-	case TYPE_HUGEINT:
+	case TYPE_HUGEINT, TYPE_UHUGEINT, TYPE_VARINT:
 		return reflect.TypeOf(big.NewInt(0))
 	case TYPE_VARCHAR, TYPE_ENUM:
 		return reflect.TypeOf("")
@@ -129,6 +354,10 @@ func (r *rows) ColumnTypeScanType(index int) reflect.Type {
 		return reflect.TypeOf(Map{})
 	case TYPE_ARRAY:
 		return reflect.TypeOf([]any{})
+	case TYPE_UNION:
+		return reflect.TypeOf(Union{})
+	case TYPE_BIT:
+		return reflect.TypeOf(Bit(""))
 	case TYPE_UUID:
 		return reflect.TypeOf([]byte{})
 	default:
@@ -136,23 +365,55 @@ func (r *rows) ColumnTypeScanType(index int) reflect.Type {
 	}
 }
 
-// ColumnTypeDatabaseTypeName implements driver.RowsColumnTypeScanType.
+// ColumnTypeDatabaseTypeName implements driver.RowsColumnTypeDatabaseTypeName.
+// It returns the full DuckDB SQL type name, including any nested types and type parameters, e.g., ENUM values.
 func (r *rows) ColumnTypeDatabaseTypeName(index int) string {
 	t := Type(C.duckdb_column_type(&r.res, C.idx_t(index)))
 	switch t {
-	case TYPE_DECIMAL, TYPE_ENUM, TYPE_LIST, TYPE_STRUCT, TYPE_MAP, TYPE_ARRAY:
+	case TYPE_DECIMAL, TYPE_ENUM, TYPE_LIST, TYPE_STRUCT, TYPE_MAP, TYPE_ARRAY, TYPE_UNION:
 		// Only allocate the logical type if necessary.
 		logicalType := C.duckdb_column_logical_type(&r.res, C.idx_t(index))
 		defer C.duckdb_destroy_logical_type(&logicalType)
 		return logicalTypeName(logicalType)
+	case TYPE_BLOB:
+		logicalType := C.duckdb_column_logical_type(&r.res, C.idx_t(index))
+		defer C.duckdb_destroy_logical_type(&logicalType)
+		if isGeometryType(logicalType) {
+			return aliasGeometry
+		}
+		return typeToStringMap[t]
 	default:
 		return typeToStringMap[t]
 	}
 }
 
+// ColumnTypeInfo returns the type information of the column at index.
+func (r *rows) ColumnTypeInfo(index int) (TypeInfo, error) {
+	if index < 0 || index >= len(r.chunk.columnNames) {
+		return nil, getError(errAPI, columnCountError(index, len(r.chunk.columnNames)))
+	}
+	logicalType := C.duckdb_column_logical_type(&r.res, C.idx_t(index))
+	defer C.duckdb_destroy_logical_type(&logicalType)
+	return newTypeInfoFromLogicalType(logicalType), nil
+}
+
+// ColumnTypeInfo returns the type information of the result column at index.
+// r must be the driver.Rows of a query executed on a DuckDB driver connection,
+// e.g., the result of (*Conn).QueryContext.
+func ColumnTypeInfo(r driver.Rows, index int) (TypeInfo, error) {
+	duckdbRows, ok := r.(*rows)
+	if !ok {
+		return nil, getError(errAPI, errInvalidRows)
+	}
+	return duckdbRows.ColumnTypeInfo(index)
+}
+
 func (r *rows) Close() error {
 	r.chunk.close()
 	C.duckdb_destroy_result(&r.res)
+	if r.cancel != nil {
+		r.cancel()
+	}
 
 	var err error
 	if r.stmt != nil {
@@ -165,77 +426,19 @@ func (r *rows) Close() error {
 	return err
 }
 
+// logicalTypeName returns the DuckDB SQL type name of a logical type, including any nested types and type parameters.
 func logicalTypeName(logicalType C.duckdb_logical_type) string {
-	t := Type(C.duckdb_get_type_id(logicalType))
-	switch t {
-	case TYPE_DECIMAL:
-		return logicalTypeNameDecimal(logicalType)
-	case TYPE_ENUM:
-		// The C API does not expose ENUM names.
-		return "ENUM"
-	case TYPE_LIST:
-		return logicalTypeNameList(logicalType)
-	case TYPE_STRUCT:
-		return logicalTypeNameStruct(logicalType)
-	case TYPE_MAP:
-		return logicalTypeNameMap(logicalType)
-	case TYPE_ARRAY:
-		return logicalTypeNameArray(logicalType)
-	default:
-		return typeToStringMap[t]
-	}
+	return newTypeInfoFromLogicalType(logicalType).String()
 }
 
-func logicalTypeNameDecimal(logicalType C.duckdb_logical_type) string {
-	width := C.duckdb_decimal_width(logicalType)
-	scale := C.duckdb_decimal_scale(logicalType)
-	return fmt.Sprintf("DECIMAL(%d,%d)", int(width), int(scale))
-}
-
-func logicalTypeNameList(logicalType C.duckdb_logical_type) string {
-	childType := C.duckdb_list_type_child_type(logicalType)
-	defer C.duckdb_destroy_logical_type(&childType)
-	childName := logicalTypeName(childType)
-	return fmt.Sprintf("%s[]", childName)
-}
-
-func logicalTypeNameStruct(logicalType C.duckdb_logical_type) string {
-	count := int(C.duckdb_struct_type_child_count(logicalType))
-	name := "STRUCT("
-
-	for i := 0; i < count; i++ {
-		ptrToChildName := C.duckdb_struct_type_child_name(logicalType, C.idx_t(i))
-		childName := C.GoString(ptrToChildName)
-		childType := C.duckdb_struct_type_child_type(logicalType, C.idx_t(i))
-
-		// Add comma if not at the end of the list.
-		name += escapeStructFieldName(childName) + " " + logicalTypeName(childType)
-		if i != count-1 {
-			name += ", "
-		}
-
-		C.duckdb_free(unsafe.Pointer(ptrToChildName))
-		C.duckdb_destroy_logical_type(&childType)
-	}
-	return name + ")"
-}
-
-func logicalTypeNameMap(logicalType C.duckdb_logical_type) string {
-	keyType := C.duckdb_map_type_key_type(logicalType)
-	defer C.duckdb_destroy_logical_type(&keyType)
-
-	valueType := C.duckdb_map_type_value_type(logicalType)
-	defer C.duckdb_destroy_logical_type(&valueType)
-
-	return fmt.Sprintf("MAP(%s, %s)", logicalTypeName(keyType), logicalTypeName(valueType))
+// quoteIdentifier quotes an identifier, e.g., a table name, for use in a query.
+func quoteIdentifier(s string) string {
+	return escapeStructFieldName(s)
 }
 
-func logicalTypeNameArray(logicalType C.duckdb_logical_type) string {
-	size := C.duckdb_array_type_array_size(logicalType)
-	childType := C.duckdb_array_type_child_type(logicalType)
-	defer C.duckdb_destroy_logical_type(&childType)
-	childName := logicalTypeName(childType)
-	return fmt.Sprintf("%s[%d]", childName, int(size))
+// quoteLiteral returns s as a SQL string literal.
+func quoteLiteral(s string) string {
+	return `'` + strings.ReplaceAll(s, `'`, `''`) + `'`
 }
 
 func escapeStructFieldName(s string) string {
@@ -42,11 +42,20 @@ type ScalarFuncConfig struct {
 }
 
 // ScalarFuncExecutor contains the callback function to execute a user-defined scalar function.
-// Currently, its only field is a row-based executor.
+// Exactly one of its executors must be set.
 type ScalarFuncExecutor struct {
 	// RowExecutor accepts a row-based execution function.
 	// []driver.Value contains the row values, and it returns the row execution result, or error.
 	RowExecutor func(values []driver.Value) (any, error)
+	// ChunkExecutor accepts a chunk-based execution function, which processes all rows of a data chunk at once.
+	// input contains one slice per input parameter, and the function writes its results to the output slice,
+	// which has one element per row. The element types are the scan types of the parameter and result types,
+	// e.g., a DOUBLE column is a []float64, and a VARCHAR column is a []string.
+	// Types without a scan type are []any.
+	// NULL input values are the zero value of their element type, and, if any input value of a row is NULL,
+	// then the output for that row is NULL. A nil output element, e.g., in a []any, is NULL, too.
+	// The ChunkExecutor does not support SpecialNullHandling.
+	ChunkExecutor func(input []any, output any) error
 }
 
 // ScalarFunc is the user-defined scalar function interface.
@@ -142,6 +151,13 @@ func scalar_udf_callback(function_info C.duckdb_function_info, input C.duckdb_da
 	}
 
 	executor := function.Executor()
+	if executor.ChunkExecutor != nil {
+		if err := executeChunk(executor.ChunkExecutor, &inputChunk, &outputChunk); err != nil {
+			setFuncError(function_info, getError(errAPI, err).Error())
+		}
+		return
+	}
+
 	nullInNullOut := !function.Config().SpecialNullHandling
 	values := make([]driver.Value, len(inputChunk.columns))
 	columnCount := len(values)
@@ -188,6 +204,41 @@ func scalar_udf_callback(function_info C.duckdb_function_info, input C.duckdb_da
 	}
 }
 
+func executeChunk(executor func(input []any, output any) error, inputChunk *DataChunk, outputChunk *DataChunk) error {
+	rowCount := inputChunk.GetSize()
+	input := make([]any, len(inputChunk.columns))
+	nullRows := make([]bool, rowCount)
+
+	for colIdx := range inputChunk.columns {
+		column := &inputChunk.columns[colIdx]
+		var err error
+		if input[colIdx], err = column.getColumn(rowCount); err != nil {
+			return addIndexToError(err, colIdx)
+		}
+		for rowIdx := 0; rowIdx < rowCount; rowIdx++ {
+			nullRows[rowIdx] = nullRows[rowIdx] || column.getNull(C.idx_t(rowIdx))
+		}
+	}
+
+	// Execute the user-defined scalar function for the data chunk.
+	result := &outputChunk.columns[0]
+	output := result.newColumn(rowCount)
+	if err := executor(input, output); err != nil {
+		return err
+	}
+
+	// Write the results to the output chunk.
+	if err := result.setColumn(output); err != nil {
+		return err
+	}
+	for rowIdx, isNull := range nullRows {
+		if isNull {
+			result.setNull(C.idx_t(rowIdx))
+		}
+	}
+	return nil
+}
+
 func registerInputParams(config ScalarFuncConfig, f C.duckdb_scalar_function) error {
 	// Set variadic input parameters.
 	if config.VariadicTypeInfo != nil {
@@ -236,9 +287,16 @@ func createScalarFunc(name string, f ScalarFunc) (C.duckdb_scalar_function, erro
 	if f == nil {
 		return nil, errScalarUDFIsNil
 	}
-	if f.Executor().RowExecutor == nil {
+	executor := f.Executor()
+	if executor.RowExecutor == nil && executor.ChunkExecutor == nil {
 		return nil, errScalarUDFNoExecutor
 	}
+	if executor.RowExecutor != nil && executor.ChunkExecutor != nil {
+		return nil, errScalarUDFMultipleExecutors
+	}
+	if executor.ChunkExecutor != nil && f.Config().SpecialNullHandling {
+		return nil, errScalarUDFChunkNullHandling
+	}
 
 	function := C.duckdb_create_scalar_function()
 
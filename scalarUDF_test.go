@@ -73,7 +73,7 @@ func (*simpleSUDF) Config() ScalarFuncConfig {
 }
 
 func (*simpleSUDF) Executor() ScalarFuncExecutor {
-	return ScalarFuncExecutor{simpleSum}
+	return ScalarFuncExecutor{RowExecutor: simpleSum}
 }
 
 func (*constantSUDF) Config() ScalarFuncConfig {
@@ -81,7 +81,7 @@ func (*constantSUDF) Config() ScalarFuncConfig {
 }
 
 func (*constantSUDF) Executor() ScalarFuncExecutor {
-	return ScalarFuncExecutor{constantOne}
+	return ScalarFuncExecutor{RowExecutor: constantOne}
 }
 
 func (*otherConstantSUDF) Config() ScalarFuncConfig {
@@ -89,7 +89,7 @@ func (*otherConstantSUDF) Config() ScalarFuncConfig {
 }
 
 func (*otherConstantSUDF) Executor() ScalarFuncExecutor {
-	return ScalarFuncExecutor{constantOne}
+	return ScalarFuncExecutor{RowExecutor: constantOne}
 }
 
 func (*typesSUDF) Config() ScalarFuncConfig {
@@ -97,7 +97,7 @@ func (*typesSUDF) Config() ScalarFuncConfig {
 }
 
 func (*typesSUDF) Executor() ScalarFuncExecutor {
-	return ScalarFuncExecutor{identity}
+	return ScalarFuncExecutor{RowExecutor: identity}
 }
 
 func (*variadicSUDF) Config() ScalarFuncConfig {
@@ -105,7 +105,7 @@ func (*variadicSUDF) Config() ScalarFuncConfig {
 }
 
 func (*variadicSUDF) Executor() ScalarFuncExecutor {
-	return ScalarFuncExecutor{variadicSum}
+	return ScalarFuncExecutor{RowExecutor: variadicSum}
 }
 
 func (*anyTypeSUDF) Config() ScalarFuncConfig {
@@ -118,7 +118,7 @@ func (*anyTypeSUDF) Config() ScalarFuncConfig {
 }
 
 func (*anyTypeSUDF) Executor() ScalarFuncExecutor {
-	return ScalarFuncExecutor{nilCount}
+	return ScalarFuncExecutor{RowExecutor: nilCount}
 }
 
 func (*errExecutorSUDF) Config() ScalarFuncConfig {
@@ -127,7 +127,7 @@ func (*errExecutorSUDF) Config() ScalarFuncConfig {
 }
 
 func (*errExecutorSUDF) Executor() ScalarFuncExecutor {
-	return ScalarFuncExecutor{nil}
+	return ScalarFuncExecutor{RowExecutor: nil}
 }
 
 func (*errInputNilSUDF) Config() ScalarFuncConfig {
@@ -135,7 +135,7 @@ func (*errInputNilSUDF) Config() ScalarFuncConfig {
 }
 
 func (*errInputNilSUDF) Executor() ScalarFuncExecutor {
-	return ScalarFuncExecutor{constantOne}
+	return ScalarFuncExecutor{RowExecutor: constantOne}
 }
 
 func (*errResultNilSUDF) Config() ScalarFuncConfig {
@@ -143,7 +143,7 @@ func (*errResultNilSUDF) Config() ScalarFuncConfig {
 }
 
 func (*errResultNilSUDF) Executor() ScalarFuncExecutor {
-	return ScalarFuncExecutor{constantOne}
+	return ScalarFuncExecutor{RowExecutor: constantOne}
 }
 
 func (*errResultAnySUDF) Config() ScalarFuncConfig {
@@ -156,7 +156,7 @@ func (*errResultAnySUDF) Config() ScalarFuncConfig {
 }
 
 func (*errResultAnySUDF) Executor() ScalarFuncExecutor {
-	return ScalarFuncExecutor{constantOne}
+	return ScalarFuncExecutor{RowExecutor: constantOne}
 }
 
 func (*errExecSUDF) Config() ScalarFuncConfig {
@@ -165,7 +165,7 @@ func (*errExecSUDF) Config() ScalarFuncConfig {
 }
 
 func (*errExecSUDF) Executor() ScalarFuncExecutor {
-	return ScalarFuncExecutor{constantError}
+	return ScalarFuncExecutor{RowExecutor: constantError}
 }
 
 func TestSimpleScalarUDF(t *testing.T) {
@@ -368,6 +368,128 @@ func TestANYScalarUDF(t *testing.T) {
 	require.NoError(t, db.Close())
 }
 
+type chunkSUDF struct {
+	config   ScalarFuncConfig
+	executor ScalarFuncExecutor
+}
+
+func (udf *chunkSUDF) Config() ScalarFuncConfig {
+	return udf.config
+}
+
+func (udf *chunkSUDF) Executor() ScalarFuncExecutor {
+	return udf.executor
+}
+
+func fma(input []any, output any) error {
+	x, y, res := input[0].([]float64), input[1].([]float64), output.([]float64)
+	for i := range res {
+		res[i] = x[i]*2 + y[i]
+	}
+	return nil
+}
+
+func repeat(input []any, output any) error {
+	strs, counts, res := input[0].([]string), input[1].([]int32), output.([][]any)
+	for i := range res {
+		if counts[i] < 0 {
+			// A nil element is NULL.
+			continue
+		}
+		list := make([]any, 0, counts[i])
+		for j := int32(0); j < counts[i]; j++ {
+			list = append(list, strs[i])
+		}
+		res[i] = list
+	}
+	return nil
+}
+
+func TestChunkScalarUDF(t *testing.T) {
+	db, err := sql.Open("duckdb", "")
+	require.NoError(t, err)
+
+	c, err := db.Conn(context.Background())
+	require.NoError(t, err)
+
+	doubleInfo, err := NewTypeInfo(TYPE_DOUBLE)
+	require.NoError(t, err)
+	fmaUDF := &chunkSUDF{
+		config:   ScalarFuncConfig{InputTypeInfos: []TypeInfo{doubleInfo, doubleInfo}, ResultTypeInfo: doubleInfo},
+		executor: ScalarFuncExecutor{ChunkExecutor: fma},
+	}
+	require.NoError(t, RegisterScalarUDF(c, "my_fma", fmaUDF))
+
+	// Exceed the size of a single data chunk.
+	var sum, count float64
+	row := c.QueryRowContext(context.Background(), `
+		SELECT SUM(my_fma(range::DOUBLE, 1)), COUNT(my_fma(range::DOUBLE, 1))
+		FROM range(5000)`)
+	require.NoError(t, row.Scan(&sum, &count))
+	require.Equal(t, float64(4999*5000+5000), sum)
+	require.Equal(t, float64(5000), count)
+
+	var res *float64
+	row = c.QueryRowContext(context.Background(), `SELECT my_fma(NULL, 1)`)
+	require.NoError(t, row.Scan(&res))
+	require.Nil(t, res)
+
+	varcharInfo, err := NewTypeInfo(TYPE_VARCHAR)
+	require.NoError(t, err)
+	intInfo, err := NewTypeInfo(TYPE_INTEGER)
+	require.NoError(t, err)
+	listInfo, err := NewListInfo(varcharInfo)
+	require.NoError(t, err)
+	repeatUDF := &chunkSUDF{
+		config:   ScalarFuncConfig{InputTypeInfos: []TypeInfo{varcharInfo, intInfo}, ResultTypeInfo: listInfo},
+		executor: ScalarFuncExecutor{ChunkExecutor: repeat},
+	}
+	require.NoError(t, RegisterScalarUDF(c, "my_repeat", repeatUDF))
+
+	rows, err := c.QueryContext(context.Background(), `
+		SELECT my_repeat(s, n)::VARCHAR
+		FROM (VALUES ('a', 2), ('b', 0), (NULL, 1), ('c', -1)) t(s, n)`)
+	require.NoError(t, err)
+	expected := []sql.NullString{{String: "[a, a]", Valid: true}, {String: "[]", Valid: true}, {}, {}}
+	i := 0
+	for rows.Next() {
+		var list sql.NullString
+		require.NoError(t, rows.Scan(&list))
+		require.Equal(t, expected[i], list)
+		i++
+	}
+	require.Equal(t, len(expected), i)
+	require.NoError(t, rows.Close())
+
+	// Errors.
+	errUDF := &chunkSUDF{
+		config: ScalarFuncConfig{InputTypeInfos: []TypeInfo{doubleInfo}, ResultTypeInfo: doubleInfo},
+		executor: ScalarFuncExecutor{ChunkExecutor: func([]any, any) error {
+			return errors.New("test invalid execution")
+		}},
+	}
+	require.NoError(t, RegisterScalarUDF(c, "err_chunk", errUDF))
+	row = c.QueryRowContext(context.Background(), `SELECT err_chunk(1)`)
+	testError(t, row.Err(), errAPI.Error(), "test invalid execution")
+
+	bothUDF := &chunkSUDF{
+		config:   ScalarFuncConfig{ResultTypeInfo: doubleInfo},
+		executor: ScalarFuncExecutor{RowExecutor: constantOne, ChunkExecutor: fma},
+	}
+	err = RegisterScalarUDF(c, "err_both", bothUDF)
+	testError(t, err, errAPI.Error(), errScalarUDFMultipleExecutors.Error())
+
+	nullHandlingUDF := &chunkSUDF{
+		config:   ScalarFuncConfig{ResultTypeInfo: doubleInfo, SpecialNullHandling: true},
+		executor: ScalarFuncExecutor{ChunkExecutor: fma},
+	}
+	err = RegisterScalarUDF(c, "err_null_handling", nullHandlingUDF)
+	testError(t, err, errAPI.Error(), errScalarUDFChunkNullHandling.Error())
+
+	require.NoError(t, c.Close())
+	require.NoError(t, db.Close())
+}
+
 func TestErrScalarUDF(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,626 @@
+package duckdb
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ScanOptions configure the conversion of nested values into Go types.
+type ScanOptions struct {
+	// Strict returns an error, if a STRUCT value contains a field without a matching Go struct field,
+	// or if a Go struct field has no matching STRUCT field.
+	// By default, unknown STRUCT fields are ignored, and Go struct fields without a match keep their zero value.
+	Strict bool
+	// CaseInsensitive matches the STRUCT fields and the Go struct fields regardless of their case,
+	// e.g., a STRUCT field hello scans into a Go struct field Hello.
+	// It returns an error, if a field has multiple matches. By default, the names must match exactly.
+	CaseInsensitive bool
+	// NullElements configures how NULL elements of LIST and ARRAY values, and NULL values of MAP values,
+	// scan into Go types that cannot be nil, e.g., the elements of a []string.
+	// By default, scanning them returns an error. Pointer, slice, map, and interface types always scan NULL into nil,
+	// e.g., the elements of a []*string.
+	NullElements NullElementMode
+	// BlobEncoding configures how BLOB values scan into Go strings. By default, the string contains the raw bytes.
+	// BLOB values always scan into []byte values as raw bytes.
+	BlobEncoding BlobEncoding
+}
+
+// BlobEncoding is the string encoding of BLOB values of ScanOptions.BlobEncoding.
+type BlobEncoding uint8
+
+const (
+	// BlobRaw scans the raw bytes into the string.
+	BlobRaw BlobEncoding = iota
+	// BlobHex scans the lowercase hexadecimal encoding of the bytes into the string, e.g., "aa01".
+	BlobHex
+	// BlobBase64 scans the standard base64 encoding of the bytes, with padding, into the string, e.g., "qgE=".
+	BlobBase64
+)
+
+func (e BlobEncoding) encode(b []byte) string {
+	switch e {
+	case BlobHex:
+		return hex.EncodeToString(b)
+	case BlobBase64:
+		return base64.StdEncoding.EncodeToString(b)
+	}
+	return string(b)
+}
+
+// NullElementMode is the NULL handling of ScanOptions.NullElements.
+type NullElementMode uint8
+
+const (
+	// NullElementsError returns an error for NULL elements.
+	NullElementsError NullElementMode = iota
+	// NullElementsZero scans NULL elements into the zero value, e.g., "" for a []string.
+	NullElementsZero
+	// NullElementsSkip omits NULL elements from slices, and NULL values from maps.
+	// Go arrays keep their length, so NULL elements scan into the zero value.
+	NullElementsSkip
+)
+
+// List is a Scanner for LIST and ARRAY values. It converts each element to T, e.g.,
+// a DECIMAL(3,2)[][] value scans into a List[[]*big.Rat] or a List[[]string].
+// A NULL element scans into a nil element, if T is a pointer, slice, map, or interface type.
+// Otherwise, scanning a NULL element returns an error.
+type List[T any] struct {
+	ScanOptions
+	t []T
+}
+
+// Get returns the scanned slice.
+func (l List[T]) Get() []T {
+	return l.t
+}
+
+// Scan implements the sql.Scanner interface.
+func (l *List[T]) Scan(v any) error {
+	return l.convert(v, reflect.ValueOf(&l.t).Elem())
+}
+
+// BlobString is a Scanner for BLOB values. It encodes the bytes into a string with the BlobEncoding
+// of its ScanOptions, e.g., BlobString{ScanOptions: ScanOptions{BlobEncoding: BlobBase64}}.
+// A NULL value scans into an empty string.
+type BlobString struct {
+	ScanOptions
+	s string
+}
+
+// Get returns the scanned string.
+func (b BlobString) Get() string {
+	return b.s
+}
+
+// Scan implements the sql.Scanner interface.
+func (b *BlobString) Scan(v any) error {
+	if v == nil {
+		b.s = ""
+		return nil
+	}
+	return b.convert(v, reflect.ValueOf(&b.s).Elem())
+}
+
+// Struct is a Scanner for STRUCT values. It converts a STRUCT value into the Go struct T.
+// Each STRUCT field scans into the exported Go struct field with the same name, and a `db:"name"` tag
+// overrides a Go struct field's name. Nested values convert recursively.
+type Struct[T any] struct {
+	ScanOptions
+	t T
+}
+
+// Get returns the scanned struct.
+func (s Struct[T]) Get() T {
+	return s.t
+}
+
+// Scan implements the sql.Scanner interface.
+func (s *Struct[T]) Scan(v any) error {
+	return s.convert(v, reflect.ValueOf(&s.t).Elem())
+}
+
+// TypedMap is a Scanner for MAP values. It converts each key to K and each value to V, e.g.,
+// a MAP(DECIMAL(3,2), STRUCT(a INTEGER)) value scans into a TypedMap[string, struct{ A int32 `db:"a"` }].
+// K must be comparable, so keys cannot convert into slices or maps. Pointer keys, e.g., *big.Rat keys,
+// compare by address and not by value. A NULL value scans into a nil value, if V is a pointer, slice, map,
+// or interface type. Otherwise, scanning a NULL value returns an error.
+// If multiple keys convert into the same K, then scanning returns an error.
+type TypedMap[K comparable, V any] struct {
+	ScanOptions
+	m map[K]V
+}
+
+// Get returns the scanned map.
+func (m TypedMap[K, V]) Get() map[K]V {
+	return m.m
+}
+
+// Scan implements the sql.Scanner interface.
+func (m *TypedMap[K, V]) Scan(v any) error {
+	return m.convert(v, reflect.ValueOf(&m.m).Elem())
+}
+
+// ScanAll scans all rows into a slice of the Go struct T, and closes the rows.
+// Each column scans into the exported field with the same name, and a `db:"name"` tag overrides a field's name.
+// Fields of embedded structs, or of embedded pointers to structs, match like the fields of T,
+// unless the embedded field has a `db` tag. Nested values, e.g., STRUCT or LIST values, convert like Struct[T].
+// Fields without a matching column keep their zero value. A column without a matching field returns an error.
+// If a row fails to scan, then ScanAll returns the previous rows and the error.
+func ScanAll[T any](rows *sql.Rows) ([]T, error) {
+	defer rows.Close()
+
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() != reflect.Struct {
+		return nil, getError(errAPI, castError(t.String(), "a struct"))
+	}
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := rowFields(t)
+	paths := make([][]int, len(columns))
+	converts := make([]bool, len(columns))
+	for i, name := range columns {
+		path, ok := fields[name]
+		if !ok {
+			return nil, getError(errAPI, structFieldError("unknown column "+name, "a field of "+t.String()))
+		}
+		paths[i] = path
+		converts[i] = needsConvert(t.FieldByIndex(path).Type)
+	}
+
+	var results []T
+	dest := make([]any, len(columns))
+	for rows.Next() {
+		var row T
+		v := reflect.ValueOf(&row).Elem()
+		for i, path := range paths {
+			field := fieldByPath(v, path)
+			if converts[i] {
+				dest[i] = fieldScanner{field}
+			} else {
+				dest[i] = field.Addr().Interface()
+			}
+		}
+		if err = rows.Scan(dest...); err != nil {
+			return results, err
+		}
+		results = append(results, row)
+	}
+	return results, rows.Err()
+}
+
+// ScanMap scans the current row into a map from the column names to the column values.
+// Call it after rows.Next, like rows.Scan. The values have the Go types of the DuckDB types, i.e.,
+// nil for NULL values, and otherwise:
+//   - BOOLEAN: bool
+//   - TINYINT, SMALLINT, INTEGER, BIGINT: int8, int16, int32, int64
+//   - UTINYINT, USMALLINT, UINTEGER, UBIGINT: uint8, uint16, uint32, uint64
+//   - FLOAT, DOUBLE: float32, float64
+//   - HUGEINT, UHUGEINT, VARINT: *big.Int
+//   - DECIMAL: Decimal
+//   - VARCHAR, ENUM: string
+//   - BLOB, UUID: []byte
+//   - BIT: Bit
+//   - DATE, TIME, TIMETZ, TIMESTAMP, TIMESTAMP_S, TIMESTAMP_MS, TIMESTAMP_NS, TIMESTAMPTZ: time.Time
+//   - INTERVAL: Interval
+//   - LIST, ARRAY: []any
+//   - STRUCT: map[string]any
+//   - MAP: Map
+//   - UNION: Union
+//   - JSON: the unmarshalled value, or json.RawMessage, if the connector enables RawJSON
+//
+// Nested values contain the same Go types. ScanMap returns an error, if the names of the columns are not unique.
+func ScanMap(rows *sql.Rows) (map[string]any, error) {
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]any, len(columns))
+	dest := make([]any, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err = rows.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]any, len(columns))
+	for i, name := range columns {
+		if _, ok := m[name]; ok {
+			return nil, getError(errAPI, duplicateNameError(name))
+		}
+		m[name] = values[i]
+	}
+	return m, nil
+}
+
+// rowFields maps the column names to the index paths of the exported fields of a Go struct,
+// including the fields of untagged embedded structs. Shallower fields take precedence.
+func rowFields(t reflect.Type) map[string][]int {
+	fields := make(map[string][]int, t.NumField())
+	var embedded [][]int
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag, hasTag := field.Tag.Lookup("db")
+		if field.Anonymous && !hasTag {
+			ft := field.Type
+			if ft.Kind() == reflect.Pointer {
+				// Scanning cannot allocate unexported embedded pointers.
+				if !field.IsExported() {
+					continue
+				}
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				embedded = append(embedded, []int{i})
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		name := field.Name
+		if hasTag {
+			name = tag
+		}
+		fields[name] = []int{i}
+	}
+
+	for _, path := range embedded {
+		ft := t.Field(path[0]).Type
+		if ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		for name, subPath := range rowFields(ft) {
+			if _, ok := fields[name]; !ok {
+				fields[name] = append(slices.Clone(path), subPath...)
+			}
+		}
+	}
+	return fields
+}
+
+// fieldByPath returns the field at the index path, and allocates nil embedded pointers on the way.
+func fieldByPath(v reflect.Value, path []int) reflect.Value {
+	for i, idx := range path {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(idx)
+	}
+	return v
+}
+
+// needsConvert returns true, if database/sql cannot scan into the type t, e.g., nested Go types.
+func needsConvert(t reflect.Type) bool {
+	for t.Kind() == reflect.Pointer {
+		if t == reflectTypeRat {
+			return true
+		}
+		t = t.Elem()
+	}
+	if reflect.PointerTo(t).Implements(reflectTypeScanner) {
+		return false
+	}
+	switch t.Kind() {
+	case reflect.Struct, reflect.Map, reflect.Array:
+		return true
+	case reflect.Slice:
+		return t.Elem().Kind() != reflect.Uint8
+	}
+	return false
+}
+
+// fieldScanner scans a value into a Go struct field with ScanOptions' conversion.
+type fieldScanner struct {
+	dst reflect.Value
+}
+
+// Scan implements the sql.Scanner interface.
+func (s fieldScanner) Scan(v any) error {
+	return ScanOptions{}.convert(v, s.dst)
+}
+
+var (
+	reflectTypeScanner = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+	reflectTypeRat     = reflect.TypeOf((*big.Rat)(nil))
+)
+
+// convert recursively converts src, a value returned by the driver, to the type of dst.
+// dst must be settable.
+func (opts ScanOptions) convert(src any, dst reflect.Value) error {
+	if src == nil {
+		switch dst.Kind() {
+		case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface:
+			dst.SetZero()
+			return nil
+		}
+		return castError("NULL", dst.Type().String())
+	}
+
+	srcValue := reflect.ValueOf(src)
+	if srcValue.Type().AssignableTo(dst.Type()) {
+		dst.Set(srcValue)
+		return nil
+	}
+
+	// Use the Scanner implementation of the destination, if any.
+	if dst.CanAddr() && dst.Addr().Type().Implements(reflectTypeScanner) {
+		return dst.Addr().Interface().(sql.Scanner).Scan(src)
+	}
+
+	if d, ok := src.(Decimal); ok {
+		return opts.convertDecimal(d, dst)
+	}
+
+	switch dst.Kind() {
+	case reflect.Pointer:
+		elem := reflect.New(dst.Type().Elem())
+		if err := opts.convert(src, elem.Elem()); err != nil {
+			return err
+		}
+		dst.Set(elem)
+		return nil
+
+	case reflect.Slice, reflect.Array:
+		return opts.convertSlice(srcValue, dst)
+
+	case reflect.Struct:
+		if m, ok := src.(map[string]any); ok {
+			return opts.convertStruct(m, dst)
+		}
+
+	case reflect.Map:
+		if m, ok := src.(Map); ok {
+			return opts.convertMap(m, dst)
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return convertNumeric(srcValue, dst)
+
+	case reflect.String:
+		if b, ok := src.([]byte); ok {
+			dst.SetString(opts.BlobEncoding.encode(b))
+			return nil
+		}
+		// Convert string types, e.g., Bit.
+		if srcValue.Kind() == reflect.String {
+			dst.SetString(srcValue.String())
+			return nil
+		}
+		if s, ok := src.(fmt.Stringer); ok {
+			dst.SetString(s.String())
+			return nil
+		}
+	}
+
+	return castError(srcValue.Type().String(), dst.Type().String())
+}
+
+func (opts ScanOptions) convertDecimal(d Decimal, dst reflect.Value) error {
+	switch {
+	case dst.Type() == reflectTypeRat:
+		factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(d.Scale)), nil)
+		dst.Set(reflect.ValueOf(new(big.Rat).SetFrac(d.Value, factor)))
+		return nil
+	case dst.Kind() == reflect.String:
+		dst.SetString(d.String())
+		return nil
+	case dst.Kind() == reflect.Float32 || dst.Kind() == reflect.Float64:
+		dst.SetFloat(d.Float64())
+		return nil
+	case dst.Kind() == reflect.Pointer:
+		elem := reflect.New(dst.Type().Elem())
+		if err := opts.convert(d, elem.Elem()); err != nil {
+			return err
+		}
+		dst.Set(elem)
+		return nil
+	}
+	return castError(reflect.TypeOf(d).String(), dst.Type().String())
+}
+
+func (opts ScanOptions) convertSlice(src reflect.Value, dst reflect.Value) error {
+	if src.Kind() != reflect.Slice {
+		return castError(src.Type().String(), dst.Type().String())
+	}
+
+	elemType := dst.Type().Elem()
+	if dst.Kind() == reflect.Array {
+		if src.Len() != dst.Len() {
+			return invalidInputError(src.Type().String()+" of length "+strconv.Itoa(src.Len()), dst.Type().String())
+		}
+		for i := 0; i < src.Len(); i++ {
+			v := src.Index(i).Interface()
+			if opts.NullElements != NullElementsError && isNullElement(v, elemType) {
+				dst.Index(i).SetZero()
+				continue
+			}
+			if err := opts.convert(v, dst.Index(i)); err != nil {
+				return addIndexToError(err, i)
+			}
+		}
+		return nil
+	}
+
+	s := reflect.MakeSlice(dst.Type(), 0, src.Len())
+	for i := 0; i < src.Len(); i++ {
+		v := src.Index(i).Interface()
+		elem := reflect.New(elemType).Elem()
+		if opts.NullElements != NullElementsError && isNullElement(v, elemType) {
+			if opts.NullElements == NullElementsSkip {
+				continue
+			}
+		} else if err := opts.convert(v, elem); err != nil {
+			return addIndexToError(err, i)
+		}
+		s = reflect.Append(s, elem)
+	}
+	dst.Set(s)
+	return nil
+}
+
+// isNullElement returns true, if v is NULL, and if values of type t cannot be nil.
+func isNullElement(v any, t reflect.Type) bool {
+	if v != nil {
+		return false
+	}
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface:
+		return false
+	}
+	return true
+}
+
+func (opts ScanOptions) convertMap(src Map, dst reflect.Value) error {
+	t := dst.Type()
+	m := reflect.MakeMapWithSize(t, len(src))
+	for k, v := range src {
+		key := reflect.New(t.Key()).Elem()
+		if err := opts.convert(k, key); err != nil {
+			return fmt.Errorf("%w: key: %v", err, k)
+		}
+		if m.MapIndex(key).IsValid() {
+			return invalidInputError(fmt.Sprintf("duplicate key %v", key), "unique keys of "+t.String())
+		}
+
+		val := reflect.New(t.Elem()).Elem()
+		if opts.NullElements != NullElementsError && isNullElement(v, t.Elem()) {
+			if opts.NullElements == NullElementsSkip {
+				continue
+			}
+		} else if err := opts.convert(v, val); err != nil {
+			return fmt.Errorf("%w: key: %v", err, k)
+		}
+		m.SetMapIndex(key, val)
+	}
+	dst.Set(m)
+	return nil
+}
+
+func (opts ScanOptions) convertStruct(src map[string]any, dst reflect.Value) error {
+	fields := structFields(dst.Type())
+	lookup := fields
+	var ambiguous map[string]bool
+	if opts.CaseInsensitive {
+		lookup, ambiguous = foldStructFields(fields)
+	}
+
+	// matched contains the indexes of the matched Go struct fields.
+	// DuckDB's STRUCT field names are unique regardless of their case.
+	matched := make(map[int]bool, len(src))
+	for name, v := range src {
+		key := name
+		if opts.CaseInsensitive {
+			key = strings.ToLower(name)
+		}
+		idx, ok := lookup[key]
+		if !ok {
+			if opts.Strict {
+				return structFieldError("unknown field "+name, "a field of "+dst.Type().String())
+			}
+			continue
+		}
+		if ambiguous[key] {
+			return structFieldError("ambiguous field "+name, "a single case-insensitive match in "+dst.Type().String())
+		}
+		matched[idx] = true
+
+		if err := opts.convert(v, dst.Field(idx)); err != nil {
+			return fmt.Errorf("%w: field: %s", err, name)
+		}
+	}
+
+	if opts.Strict && len(fields) != len(matched) {
+		for name, idx := range fields {
+			if !matched[idx] {
+				return structFieldError("missing field", name)
+			}
+		}
+	}
+	return nil
+}
+
+// foldStructFields maps the lower-case field names to the field indexes.
+// It also returns the lower-case names shared by multiple fields.
+func foldStructFields(fields map[string]int) (map[string]int, map[string]bool) {
+	folded := make(map[string]int, len(fields))
+	ambiguous := make(map[string]bool)
+	for name, idx := range fields {
+		key := strings.ToLower(name)
+		if _, ok := folded[key]; ok {
+			ambiguous[key] = true
+		}
+		folded[key] = idx
+	}
+	return folded, ambiguous
+}
+
+// structFields maps the STRUCT field names to the indexes of the exported fields of a Go struct.
+// The field name defaults to the Go field name, and a `db` tag overrides it.
+func structFields(t reflect.Type) map[string]int {
+	fields := make(map[string]int, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := field.Name
+		if tag, ok := field.Tag.Lookup("db"); ok {
+			name = tag
+		}
+		fields[name] = i
+	}
+	return fields
+}
+
+func convertNumeric(src reflect.Value, dst reflect.Value) error {
+	overflow := false
+	switch src.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v := src.Int()
+		switch dst.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			overflow = dst.OverflowInt(v)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			overflow = v < 0 || dst.OverflowUint(uint64(v))
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v := src.Uint()
+		switch dst.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			overflow = v > uint64(1<<63-1) || dst.OverflowInt(int64(v))
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			overflow = dst.OverflowUint(v)
+		}
+	case reflect.Float32, reflect.Float64:
+		// Floating-point values only convert to floating-point values.
+		if dst.Kind() != reflect.Float32 && dst.Kind() != reflect.Float64 {
+			return castError(src.Type().String(), dst.Type().String())
+		}
+		overflow = dst.OverflowFloat(src.Float())
+	default:
+		return castError(src.Type().String(), dst.Type().String())
+	}
+
+	if overflow {
+		return castError(fmt.Sprintf("%s(%v)", src.Type().String(), src.Interface()), dst.Type().String())
+	}
+	dst.Set(src.Convert(dst.Type()))
+	return nil
+}
@@ -0,0 +1,154 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ColumnDef describes a column of a table, as listed by duckdb_columns().
+type ColumnDef struct {
+	// Name is the name of the column.
+	Name string
+	// Type is the type information of the column.
+	Type TypeInfo
+	// Nullable is true, if the column accepts NULL values.
+	Nullable bool
+	// Default is the SQL expression of the column's default value, e.g., 'x' or nextval('seq'), or empty.
+	Default string
+	// PrimaryKey is true, if the column is part of the table's primary key.
+	PrimaryKey bool
+	// Unique is true, if the column is part of a UNIQUE constraint.
+	Unique bool
+}
+
+// TableSchema returns the column definitions of the table in schema, in the order of the table's columns.
+// An empty schema resolves to the connection's current schema.
+func (c *Conn) TableSchema(ctx context.Context, schema, table string) ([]ColumnDef, error) {
+	if table == "" {
+		return nil, getError(errAPI, errEmptyName)
+	}
+	name := quoteIdentifier(table)
+	if schema != "" {
+		name = quoteIdentifier(schema) + "." + name
+	}
+
+	// The empty result of the table contains the logical types of its columns.
+	types, err := c.tableColumnTypes(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	args := []driver.NamedValue{{Ordinal: 1, Value: schema}, {Ordinal: 2, Value: table}}
+	defs := make([]ColumnDef, 0, len(types))
+	err = c.queryValues(ctx, `SELECT column_name, is_nullable, column_default FROM duckdb_columns()
+		WHERE schema_name = coalesce(nullif($1, ''), current_schema()) AND table_name = $2
+		AND database_name = current_database() ORDER BY column_index`, args, 3, func(values []driver.Value) {
+		def := ColumnDef{
+			Name:     values[0].(string),
+			Nullable: values[1].(bool),
+		}
+		if len(defs) < len(types) {
+			def.Type = types[len(defs)]
+		}
+		def.Default, _ = values[2].(string)
+		defs = append(defs, def)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.queryValues(ctx, `SELECT constraint_type, constraint_column_indexes FROM duckdb_constraints()
+		WHERE schema_name = coalesce(nullif($1, ''), current_schema()) AND table_name = $2
+		AND database_name = current_database() AND constraint_type IN ('PRIMARY KEY', 'UNIQUE')`, args, 2,
+		func(values []driver.Value) {
+			indexes, _ := values[1].([]any)
+			for _, index := range indexes {
+				i, ok := index.(int64)
+				if !ok || i < 0 || int(i) >= len(defs) {
+					continue
+				}
+				if values[0].(string) == "PRIMARY KEY" {
+					defs[i].PrimaryKey = true
+				} else {
+					defs[i].Unique = true
+				}
+			}
+		})
+	if err != nil {
+		return nil, err
+	}
+	return defs, nil
+}
+
+// CreateType creates the named type name in the connection's current schema, e.g., CREATE TYPE greeting AS ENUM ('hello', 'world'),
+// so that later queries can reference the type info by name. E.g., info is the ENUM type of NewEnumInfo, or the STRUCT type
+// of NewStructInfo. CreateType returns an error, if a type with the name already exists in the current schema.
+func (c *Conn) CreateType(ctx context.Context, name string, info TypeInfo) error {
+	if name == "" {
+		return getError(errAPI, errEmptyName)
+	}
+	if strings.ContainsRune(name, 0) {
+		return getError(errAPI, errNULByteInName)
+	}
+	if info == nil {
+		return getError(errAPI, interfaceIsNilError("info"))
+	}
+
+	// DuckDB resolves type names case-insensitively.
+	exists := false
+	args := []driver.NamedValue{{Ordinal: 1, Value: name}}
+	err := c.queryValues(ctx, `SELECT 1 FROM duckdb_types() WHERE lower(type_name) = lower($1)
+		AND schema_name = current_schema() AND database_name = current_database()`, args, 1, func([]driver.Value) {
+		exists = true
+	})
+	if err != nil {
+		return err
+	}
+	if exists {
+		return getError(errAPI, fmt.Errorf("%w: %s", errTypeExists, quoteIdentifier(name)))
+	}
+
+	_, err = c.ExecContext(ctx, "CREATE TYPE "+quoteIdentifier(name)+" AS "+info.String(), nil)
+	return err
+}
+
+// tableColumnTypes returns the type information of the columns of the table with the qualified name.
+func (c *Conn) tableColumnTypes(ctx context.Context, name string) ([]TypeInfo, error) {
+	driverRows, err := c.QueryContext(ctx, "SELECT * FROM "+name+" LIMIT 0", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer driverRows.Close()
+
+	r := driverRows.(*rows)
+	types := make([]TypeInfo, len(r.Columns()))
+	for i := range types {
+		if types[i], err = r.ColumnTypeInfo(i); err != nil {
+			return nil, err
+		}
+	}
+	return types, nil
+}
+
+// queryValues executes the query with args and calls f with the values of each row.
+func (c *Conn) queryValues(ctx context.Context, query string, args []driver.NamedValue, columns int, f func([]driver.Value)) error {
+	rows, err := c.QueryContext(ctx, query, args)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	values := make([]driver.Value, columns)
+	for {
+		if err = rows.Next(values); err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		f(values)
+	}
+}
@@ -0,0 +1,128 @@
+package duckdb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableSchema(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	_, err := db.Exec(`CREATE SCHEMA s;
+		CREATE TABLE s.t (
+			id INTEGER PRIMARY KEY,
+			name VARCHAR NOT NULL DEFAULT 'x',
+			code INTEGER UNIQUE,
+			tags VARCHAR[],
+			a INTEGER,
+			b INTEGER,
+			UNIQUE (a, b)
+		);
+		CREATE TABLE t (v DOUBLE)`)
+	require.NoError(t, err)
+
+	conn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+
+	err = conn.Raw(func(driverConn any) error {
+		c := driverConn.(*Conn)
+
+		defs, err := c.TableSchema(context.Background(), "s", "t")
+		require.NoError(t, err)
+		require.Len(t, defs, 6)
+
+		expected := []struct {
+			name       string
+			typ        string
+			nullable   bool
+			def        string
+			primaryKey bool
+			unique     bool
+		}{
+			{"id", "INTEGER", false, "", true, false},
+			{"name", "VARCHAR", false, "'x'", false, false},
+			{"code", "INTEGER", true, "", false, true},
+			{"tags", "VARCHAR[]", true, "", false, false},
+			{"a", "INTEGER", true, "", false, true},
+			{"b", "INTEGER", true, "", false, true},
+		}
+		for i, e := range expected {
+			require.Equal(t, e.name, defs[i].Name)
+			require.Equal(t, e.typ, defs[i].Type.String())
+			require.Equal(t, e.nullable, defs[i].Nullable)
+			require.Equal(t, e.def, defs[i].Default)
+			require.Equal(t, e.primaryKey, defs[i].PrimaryKey)
+			require.Equal(t, e.unique, defs[i].Unique)
+		}
+		child, err := defs[3].Type.ChildType()
+		require.NoError(t, err)
+		require.Equal(t, TYPE_VARCHAR, child.InternalType())
+
+		// An empty schema resolves to the current schema.
+		defs, err = c.TableSchema(context.Background(), "", "t")
+		require.NoError(t, err)
+		require.Len(t, defs, 1)
+		require.Equal(t, "v", defs[0].Name)
+		require.Equal(t, TYPE_DOUBLE, defs[0].Type.InternalType())
+
+		_, err = c.TableSchema(context.Background(), "s", "not_exist")
+		require.ErrorContains(t, err, "not_exist")
+		_, err = c.TableSchema(context.Background(), "s", "")
+		testError(t, err, errAPI.Error(), errEmptyName.Error())
+		return nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+	require.NoError(t, db.Close())
+}
+
+func TestCreateType(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	conn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+
+	enumInfo, err := NewEnumInfo("hello", "world")
+	require.NoError(t, err)
+	varcharInfo, err := NewTypeInfo(TYPE_VARCHAR)
+	require.NoError(t, err)
+	entry, err := NewStructEntry(varcharInfo, "name")
+	require.NoError(t, err)
+	structInfo, err := NewStructInfo(entry)
+	require.NoError(t, err)
+
+	err = conn.Raw(func(driverConn any) error {
+		c := driverConn.(*Conn)
+		require.NoError(t, c.CreateType(context.Background(), "greeting", enumInfo))
+		require.NoError(t, c.CreateType(context.Background(), "person", structInfo))
+
+		err = c.CreateType(context.Background(), "Greeting", structInfo)
+		testError(t, err, errAPI.Error(), errTypeExists.Error(), `"Greeting"`)
+		err = c.CreateType(context.Background(), "", enumInfo)
+		testError(t, err, errAPI.Error(), errEmptyName.Error())
+		err = c.CreateType(context.Background(), "a\x00b", enumInfo)
+		testError(t, err, errAPI.Error(), errNULByteInName.Error())
+		err = c.CreateType(context.Background(), "nothing", nil)
+		testError(t, err, errAPI.Error(), interfaceIsNilErrMsg)
+		return nil
+	})
+	require.NoError(t, err)
+
+	// Later queries reference the types by name.
+	_, err = conn.ExecContext(context.Background(), `CREATE TABLE test (g greeting, p person);
+		INSERT INTO test VALUES ('world', {'name': 'duck'})`)
+	require.NoError(t, err)
+	var g, name string
+	err = conn.QueryRowContext(context.Background(), `SELECT g, p.name FROM test`).Scan(&g, &name)
+	require.NoError(t, err)
+	require.Equal(t, "world", g)
+	require.Equal(t, "duck", name)
+
+	_, err = conn.ExecContext(context.Background(), `INSERT INTO test VALUES ('bye', NULL)`)
+	require.Error(t, err)
+
+	require.NoError(t, conn.Close())
+	require.NoError(t, db.Close())
+}
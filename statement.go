@@ -11,17 +11,90 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"reflect"
+	"strconv"
+	"sync"
 	"time"
 	"unsafe"
 )
 
+// StatementType wraps the corresponding DuckDB statement type enum.
+type StatementType C.duckdb_statement_type
+
+const (
+	STATEMENT_TYPE_INVALID      StatementType = C.DUCKDB_STATEMENT_TYPE_INVALID
+	STATEMENT_TYPE_SELECT       StatementType = C.DUCKDB_STATEMENT_TYPE_SELECT
+	STATEMENT_TYPE_INSERT       StatementType = C.DUCKDB_STATEMENT_TYPE_INSERT
+	STATEMENT_TYPE_UPDATE       StatementType = C.DUCKDB_STATEMENT_TYPE_UPDATE
+	STATEMENT_TYPE_EXPLAIN      StatementType = C.DUCKDB_STATEMENT_TYPE_EXPLAIN
+	STATEMENT_TYPE_DELETE       StatementType = C.DUCKDB_STATEMENT_TYPE_DELETE
+	STATEMENT_TYPE_PREPARE      StatementType = C.DUCKDB_STATEMENT_TYPE_PREPARE
+	STATEMENT_TYPE_CREATE       StatementType = C.DUCKDB_STATEMENT_TYPE_CREATE
+	STATEMENT_TYPE_EXECUTE      StatementType = C.DUCKDB_STATEMENT_TYPE_EXECUTE
+	STATEMENT_TYPE_ALTER        StatementType = C.DUCKDB_STATEMENT_TYPE_ALTER
+	STATEMENT_TYPE_TRANSACTION  StatementType = C.DUCKDB_STATEMENT_TYPE_TRANSACTION
+	STATEMENT_TYPE_COPY         StatementType = C.DUCKDB_STATEMENT_TYPE_COPY
+	STATEMENT_TYPE_ANALYZE      StatementType = C.DUCKDB_STATEMENT_TYPE_ANALYZE
+	STATEMENT_TYPE_VARIABLE_SET StatementType = C.DUCKDB_STATEMENT_TYPE_VARIABLE_SET
+	STATEMENT_TYPE_CREATE_FUNC  StatementType = C.DUCKDB_STATEMENT_TYPE_CREATE_FUNC
+	STATEMENT_TYPE_DROP         StatementType = C.DUCKDB_STATEMENT_TYPE_DROP
+	STATEMENT_TYPE_EXPORT       StatementType = C.DUCKDB_STATEMENT_TYPE_EXPORT
+	STATEMENT_TYPE_PRAGMA       StatementType = C.DUCKDB_STATEMENT_TYPE_PRAGMA
+	STATEMENT_TYPE_VACUUM       StatementType = C.DUCKDB_STATEMENT_TYPE_VACUUM
+	STATEMENT_TYPE_CALL         StatementType = C.DUCKDB_STATEMENT_TYPE_CALL
+	STATEMENT_TYPE_SET          StatementType = C.DUCKDB_STATEMENT_TYPE_SET
+	STATEMENT_TYPE_LOAD         StatementType = C.DUCKDB_STATEMENT_TYPE_LOAD
+	STATEMENT_TYPE_RELATION     StatementType = C.DUCKDB_STATEMENT_TYPE_RELATION
+	STATEMENT_TYPE_EXTENSION    StatementType = C.DUCKDB_STATEMENT_TYPE_EXTENSION
+	STATEMENT_TYPE_LOGICAL_PLAN StatementType = C.DUCKDB_STATEMENT_TYPE_LOGICAL_PLAN
+	STATEMENT_TYPE_ATTACH       StatementType = C.DUCKDB_STATEMENT_TYPE_ATTACH
+	STATEMENT_TYPE_DETACH       StatementType = C.DUCKDB_STATEMENT_TYPE_DETACH
+	STATEMENT_TYPE_MULTI        StatementType = C.DUCKDB_STATEMENT_TYPE_MULTI
+)
+
+// StatementTyper is implemented by the prepared statements of the driver, and by the driver.Result and
+// driver.Rows of executed statements.
+type StatementTyper interface {
+	// StatementType returns the type of the statement, e.g., STATEMENT_TYPE_SELECT.
+	StatementType() StatementType
+}
+
 // Stmt implements the driver.Stmt interface.
+// A Stmt is not safe for concurrent use, except for Interrupt, which any goroutine can call.
 type Stmt struct {
 	c                *Conn
 	stmt             *C.duckdb_prepared_statement
 	closeOnRowsClose bool
 	closed           bool
 	rows             bool
+
+	// mu protects running, which is true while the statement executes.
+	mu      sync.Mutex
+	running bool
+}
+
+// Interrupt aborts the execution of the statement, e.g., a long-running query executing on another goroutine,
+// which then returns an error of type ErrorTypeInterrupt. For streaming results, Interrupt also aborts fetching
+// the next chunk. Interrupt is a no-op, if the statement is not executing, e.g., after its execution completed.
+func (s *Stmt) Interrupt() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.running {
+		C.duckdb_interrupt(s.c.duckdbCon)
+	}
+}
+
+// run runs f, which executes the statement, and marks the statement as running for Interrupt.
+func (s *Stmt) run(ctx context.Context, f func()) {
+	s.setRunning(true)
+	defer s.setRunning(false)
+	s.c.interruptible(ctx, f)
+}
+
+func (s *Stmt) setRunning(running bool) {
+	s.mu.Lock()
+	s.running = running
+	s.mu.Unlock()
 }
 
 // Close closes the statement.
@@ -49,18 +122,144 @@ func (s *Stmt) NumInput() int {
 	return int(paramCount)
 }
 
+// ParamName returns the name of the parameter at the given index n, starting at 1.
+// It returns an empty string for positional parameters, e.g., ?.
+func (s *Stmt) ParamName(n int) (string, error) {
+	if err := s.checkParamIndex(n); err != nil {
+		return "", err
+	}
+
+	// Positional parameters are named after their index.
+	paramName := s.paramName(n)
+	if paramName == strconv.Itoa(n) {
+		return "", nil
+	}
+	return paramName, nil
+}
+
+func (s *Stmt) paramName(n int) string {
+	name := C.duckdb_parameter_name(*s.stmt, C.idx_t(n))
+	defer C.duckdb_free(unsafe.Pointer(name))
+	return C.GoString(name)
+}
+
+// ParamType returns the expected type of the parameter at the given index n, starting at 1.
+// It returns TYPE_INVALID, if DuckDB cannot infer the type of the parameter.
+func (s *Stmt) ParamType(n int) (Type, error) {
+	if err := s.checkParamIndex(n); err != nil {
+		return TYPE_INVALID, err
+	}
+	return Type(C.duckdb_param_type(*s.stmt, C.idx_t(n))), nil
+}
+
+// StatementType returns the type of the prepared statement, e.g., STATEMENT_TYPE_SELECT,
+// which determines whether executing it returns rows, before executing it.
+// It returns STATEMENT_TYPE_INVALID for a closed statement.
+func (s *Stmt) StatementType() StatementType {
+	if s.closed {
+		return STATEMENT_TYPE_INVALID
+	}
+	return StatementType(C.duckdb_prepared_statement_type(*s.stmt))
+}
+
+// ParamTypes returns the expected type information of each parameter, in the order of the parameter indexes.
+// If DuckDB cannot infer the type of a parameter, e.g., in SELECT ?, then its TypeInfo has the InternalType
+// TYPE_INVALID. DuckDB's C API only exposes the Type of a parameter, so parameters of types with
+// type parameters, e.g., DECIMAL, ENUM, or nested types, also have the InternalType TYPE_INVALID.
+// ParamType returns their Type.
+func (s *Stmt) ParamTypes() ([]TypeInfo, error) {
+	if s.closed {
+		return nil, getError(errAPI, errClosedStmt)
+	}
+	types := make([]TypeInfo, s.NumInput())
+	for i := range types {
+		t := Type(C.duckdb_param_type(*s.stmt, C.idx_t(i+1)))
+		info, err := NewTypeInfo(t)
+		if err != nil {
+			info = &typeInfo{baseTypeInfo: baseTypeInfo{Type: TYPE_INVALID}}
+		}
+		types[i] = info
+	}
+	return types, nil
+}
+
+func (s *Stmt) checkParamIndex(n int) error {
+	if s.closed {
+		return getError(errAPI, errClosedStmt)
+	}
+	if n < 1 || n > s.NumInput() {
+		return getError(errAPI, paramIndexError(n, s.NumInput()))
+	}
+	return nil
+}
+
+// Bind binds the arguments to the parameters of the statement, so that ExecBound or QueryBound
+// can execute the statement. Reusing a statement with different arguments avoids preparing the query again.
+func (s *Stmt) Bind(args []driver.NamedValue) error {
+	if s.closed {
+		return getError(errAPI, errClosedStmt)
+	}
+	return s.bind(args)
+}
+
+// ExecBound executes the statement with the arguments of the last call to Bind.
+// It is the equivalent of ExecContext for bound statements.
+func (s *Stmt) ExecBound(ctx context.Context) (driver.Result, error) {
+	if s.closed {
+		return nil, getError(errAPI, errClosedStmt)
+	}
+	return s.exec(ctx, s.executeBound)
+}
+
+// QueryBound executes the statement with the arguments of the last call to Bind, and returns its rows.
+// It is the equivalent of QueryContext for bound statements.
+func (s *Stmt) QueryBound(ctx context.Context) (driver.Rows, error) {
+	if s.closed {
+		return nil, getError(errAPI, errClosedStmt)
+	}
+	return s.query(ctx, s.executeBound)
+}
+
+// checkNamedArgs returns an error, if the arguments mix named and positional arguments,
+// or if the name of a named argument does not match any parameter.
+func (s *Stmt) checkNamedArgs(args []driver.NamedValue) error {
+	named := 0
+	for _, arg := range args {
+		if arg.Name != "" {
+			named++
+		}
+	}
+	if named == 0 {
+		return nil
+	}
+	if named != len(args) {
+		return errMixedNamedArgs
+	}
+
+	names := make(map[string]struct{}, s.NumInput())
+	for i := 1; i <= s.NumInput(); i++ {
+		names[s.paramName(i)] = struct{}{}
+	}
+	for _, arg := range args {
+		if _, ok := names[arg.Name]; !ok {
+			return fmt.Errorf("%w: %s", errUnknownNamedArg, arg.Name)
+		}
+	}
+	return nil
+}
+
 func (s *Stmt) bind(args []driver.NamedValue) error {
 	if s.NumInput() > len(args) {
 		return fmt.Errorf("incorrect argument count for command: have %d want %d", len(args), s.NumInput())
 	}
 
-	// FIXME (feature): we can't pass nested types as parameters (bind_value) yet
+	if err := s.checkNamedArgs(args); err != nil {
+		return err
+	}
 
 	// relaxed length check allow for unused parameters.
 	for i := 0; i < s.NumInput(); i++ {
-		name := C.duckdb_parameter_name(*s.stmt, C.idx_t(i+1))
-		paramName := C.GoString(name)
-		C.duckdb_free(unsafe.Pointer(name))
+		paramName := s.paramName(i + 1)
 
 		// fallback on index position
 		arg := args[i]
@@ -79,6 +278,11 @@ func (s *Stmt) bind(args []driver.NamedValue) error {
 			}
 		}
 
+		// Bind typed nil pointers, e.g., a (*big.Int)(nil), as NULL, like nil values.
+		if isNull(arg.Value) {
+			arg.Value = nil
+		}
+
 		switch v := arg.Value.(type) {
 		case bool:
 			if rv := C.duckdb_bind_boolean(*s.stmt, C.idx_t(i+1), C.bool(v)); rv == C.DuckDBError {
@@ -105,6 +309,27 @@ func (s *Stmt) bind(args []driver.NamedValue) error {
 				return errCouldNotBind
 			}
 		case *big.Int:
+			// DuckDB's C API cannot bind VARINT values, so it casts the string representation.
+			if Type(C.duckdb_param_type(*s.stmt, C.idx_t(i+1))) == TYPE_VARINT {
+				val := C.CString(v.String())
+				rv := C.duckdb_bind_varchar(*s.stmt, C.idx_t(i+1), val)
+				C.duckdb_free(unsafe.Pointer(val))
+				if rv == C.DuckDBError {
+					return errCouldNotBind
+				}
+				break
+			}
+			// Bind values exceeding the HUGEINT range as UHUGEINT.
+			if v.Sign() > 0 && v.BitLen() == 128 {
+				val, err := uhugeIntFromNative(v)
+				if err != nil {
+					return err
+				}
+				if rv := C.duckdb_bind_uhugeint(*s.stmt, C.idx_t(i+1), val); rv == C.DuckDBError {
+					return errCouldNotBind
+				}
+				break
+			}
 			val, err := hugeIntFromNative(v)
 			if err != nil {
 				return err
@@ -152,10 +377,19 @@ func (s *Stmt) bind(args []driver.NamedValue) error {
 			}
 			C.duckdb_free(unsafe.Pointer(val))
 		case time.Time:
-			val := C.duckdb_timestamp{
-				micros: C.int64_t(v.UTC().UnixMicro()),
+			if rv := s.bindTime(i, v); rv == C.DuckDBError {
+				return errCouldNotBind
+			}
+		case Decimal:
+			if v.Value == nil || v.Width == 0 || v.Width > max_decimal_width || v.Scale > v.Width {
+				return invalidDecimalError(errCouldNotBind, v.Width, v.Scale)
+			}
+			val, err := hugeIntFromNative(v.Value)
+			if err != nil {
+				return err
 			}
-			if rv := C.duckdb_bind_timestamp(*s.stmt, C.idx_t(i+1), val); rv == C.DuckDBError {
+			dec := C.duckdb_decimal{width: C.uint8_t(v.Width), scale: C.uint8_t(v.Scale), value: val}
+			if rv := C.duckdb_bind_decimal(*s.stmt, C.idx_t(i+1), dec); rv == C.DuckDBError {
 				return errCouldNotBind
 			}
 		case Interval:
@@ -172,13 +406,106 @@ func (s *Stmt) bind(args []driver.NamedValue) error {
 				return errCouldNotBind
 			}
 		default:
-			return driver.ErrSkip
+			if err := s.bindValue(i, v); err != nil {
+				return err
+			}
 		}
 	}
 
 	return nil
 }
 
+// bindTime binds a time.Time with the encoding of the parameter type, e.g., as a DATE for a DATE parameter.
+// Like the appender, it binds the UTC date and time of t, except for TIME_TZ parameters, which keep the local
+// time of t and its offset. It truncates the value to the precision of TIMESTAMP_S and TIMESTAMP_MS parameters,
+// and binds all other parameters as TIMESTAMP values.
+func (s *Stmt) bindTime(i int, t time.Time) C.duckdb_state {
+	n := C.idx_t(i + 1)
+	switch Type(C.duckdb_param_type(*s.stmt, n)) {
+	case TYPE_DATE:
+		days := t.UTC().Unix() / secondsPerDay
+		if t.UTC().Unix()%secondsPerDay < 0 {
+			days--
+		}
+		return C.duckdb_bind_date(*s.stmt, n, C.duckdb_date{days: C.int32_t(days)})
+	case TYPE_TIME:
+		return C.duckdb_bind_time(*s.stmt, n, C.duckdb_time{micros: C.int64_t(timeToMicros(t.UTC()))})
+	case TYPE_TIME_TZ:
+		_, offset := t.Zone()
+		val := C.duckdb_create_time_tz_value(C.duckdb_create_time_tz(C.int64_t(timeToMicros(t)), C.int32_t(offset)))
+		defer C.duckdb_destroy_value(&val)
+		return C.duckdb_bind_value(*s.stmt, n, val)
+	case TYPE_TIMESTAMP_TZ:
+		return C.duckdb_bind_timestamp_tz(*s.stmt, n, C.duckdb_timestamp{micros: C.int64_t(t.UTC().UnixMicro())})
+	case TYPE_TIMESTAMP_S:
+		t = t.Truncate(time.Second)
+	case TYPE_TIMESTAMP_MS:
+		t = t.Truncate(time.Millisecond)
+	case TYPE_TIMESTAMP_NS:
+		// DuckDB's C API cannot bind nanoseconds, so it casts the string representation.
+		str := C.CString(t.UTC().Format("2006-01-02 15:04:05.999999999"))
+		defer C.duckdb_free(unsafe.Pointer(str))
+		return C.duckdb_bind_varchar(*s.stmt, n, str)
+	}
+	return C.duckdb_bind_timestamp(*s.stmt, n, C.duckdb_timestamp{micros: C.int64_t(t.UTC().UnixMicro())})
+}
+
+// bindValue binds Go slices, arrays, and structs as LIST, ARRAY, and STRUCT values.
+// The Go types determine the DuckDB types, see valueTypeInfo.
+// Go maps bind as MAP values for MAP parameters, and as LIST values of their STRUCT(key, value) entries otherwise.
+func (s *Stmt) bindValue(i int, v any) error {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Struct, reflect.Map:
+	default:
+		return driver.ErrSkip
+	}
+
+	info, err := valueTypeInfo(rv.Type())
+	if err != nil {
+		return addIndexToError(err, i+1)
+	}
+	expected := Type(C.duckdb_param_type(*s.stmt, C.idx_t(i+1)))
+	if err = checkParamValueType(expected, info, rv.Kind()); err != nil {
+		return addIndexToError(err, i+1)
+	}
+	val, err := createValue(info, rv)
+	if err != nil {
+		return addIndexToError(err, i+1)
+	}
+	if rv.Kind() == reflect.Map && expected == TYPE_MAP {
+		entries := val
+		val, err = s.c.mapValue(entries)
+		C.duckdb_destroy_value(&entries)
+		if err != nil {
+			return addIndexToError(err, i+1)
+		}
+	}
+	defer C.duckdb_destroy_value(&val)
+
+	if rv := C.duckdb_bind_value(*s.stmt, C.idx_t(i+1), val); rv == C.DuckDBError {
+		return errCouldNotBind
+	}
+	return nil
+}
+
+// checkParamValueType compares the type of a nested value with the expected parameter type, if DuckDB infers it.
+// Any value casts to VARCHAR, and LIST and ARRAY values cast to each other. Go maps bind as MAP values
+// for MAP parameters, and as LIST values of their entries otherwise.
+func checkParamValueType(expected Type, info TypeInfo, kind reflect.Kind) error {
+	actual := info.InternalType()
+
+	switch {
+	case expected == TYPE_INVALID, expected == TYPE_ANY, expected == TYPE_VARCHAR, expected == actual:
+		return nil
+	case expected == TYPE_ARRAY && actual == TYPE_LIST, expected == TYPE_LIST && actual == TYPE_ARRAY:
+		return nil
+	case expected == TYPE_MAP && kind == reflect.Map:
+		return nil
+	}
+	return typeInfoMismatchError(actual, expected)
+}
+
 // Deprecated: Use ExecContext instead.
 func (s *Stmt) Exec(args []driver.Value) (driver.Result, error) {
 	return s.ExecContext(context.Background(), argsToNamedArgs(args))
@@ -187,14 +514,24 @@ func (s *Stmt) Exec(args []driver.Value) (driver.Result, error) {
 // ExecContext executes a query that doesn't return rows, such as an INSERT or UPDATE.
 // It implements the driver.StmtExecContext interface.
 func (s *Stmt) ExecContext(ctx context.Context, nargs []driver.NamedValue) (driver.Result, error) {
-	res, err := s.execute(ctx, nargs)
+	return s.exec(ctx, func(ctx context.Context, stream bool) (*C.duckdb_result, error) {
+		return s.execute(ctx, nargs, stream)
+	})
+}
+
+// exec executes the statement with execute, and returns its result.
+// It applies the connection's query timeout to the execution.
+func (s *Stmt) exec(ctx context.Context, execute func(ctx context.Context, stream bool) (*C.duckdb_result, error)) (driver.Result, error) {
+	ctx, cancel := s.c.queryContext(ctx)
+	defer cancel()
+
+	res, err := execute(ctx, false)
 	if err != nil {
 		return nil, err
 	}
 	defer C.duckdb_destroy_result(res)
 
-	ra := int64(C.duckdb_value_int64(res, 0, 0))
-	return &result{ra}, nil
+	return newResult(res), nil
 }
 
 // Deprecated: Use QueryContext instead.
@@ -205,17 +542,32 @@ func (s *Stmt) Query(args []driver.Value) (driver.Rows, error) {
 // QueryContext executes a query that may return rows, such as a SELECT.
 // It implements the driver.StmtQueryContext interface.
 func (s *Stmt) QueryContext(ctx context.Context, nargs []driver.NamedValue) (driver.Rows, error) {
-	res, err := s.execute(ctx, nargs)
+	return s.query(ctx, func(ctx context.Context, stream bool) (*C.duckdb_result, error) {
+		return s.execute(ctx, nargs, stream)
+	})
+}
+
+// query executes the statement with execute, and returns its rows.
+// It applies the connection's query timeout to the execution. The rows of a streamed result keep
+// the query's context, as fetching their chunks executes the query.
+func (s *Stmt) query(ctx context.Context, execute func(ctx context.Context, stream bool) (*C.duckdb_result, error)) (driver.Rows, error) {
+	ctx, cancel := s.c.queryContext(ctx)
+	res, err := execute(ctx, s.c.streamResults)
 	if err != nil {
+		cancel()
 		return nil, err
 	}
 	s.rows = true
-	return newRowsWithStmt(*res, s), nil
+	r := newRowsWithStmt(*res, s)
+	if r.streaming {
+		r.ctx, r.cancel = ctx, cancel
+	} else {
+		cancel()
+	}
+	return r, nil
 }
 
-// This method executes the query in steps and checks if context is cancelled before executing each step.
-// It uses Pending Result Interface C APIs to achieve this. Reference - https://duckdb.org/docs/api/c/api#pending-result-interface
-func (s *Stmt) execute(ctx context.Context, args []driver.NamedValue) (*C.duckdb_result, error) {
+func (s *Stmt) execute(ctx context.Context, args []driver.NamedValue, stream bool) (*C.duckdb_result, error) {
 	if s.closed {
 		panic("database/sql/driver: misuse of duckdb driver: ExecContext or QueryContext after Close")
 	}
@@ -226,36 +578,40 @@ func (s *Stmt) execute(ctx context.Context, args []driver.NamedValue) (*C.duckdb
 	if err := s.bind(args); err != nil {
 		return nil, err
 	}
+	return s.executeBound(ctx, stream)
+}
+
+// executeBound executes the statement with its bound arguments with DuckDB's pending result interface,
+// and interrupts the execution once ctx is done. Reference - https://duckdb.org/docs/api/c/api#pending-result-interface
+// If stream is true, DuckDB streams the result, i.e., it produces each chunk when fetching it.
+func (s *Stmt) executeBound(ctx context.Context, stream bool) (*C.duckdb_result, error) {
+	if s.rows {
+		return nil, getError(errAPI, errActiveRows)
+	}
+
+	// Do not start executing the statement if the context is already done.
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 
 	var pendingRes C.duckdb_pending_result
-	if state := C.duckdb_pending_prepared(*s.stmt, &pendingRes); state == C.DuckDBError {
+	var state C.duckdb_state
+	if stream {
+		state = C.duckdb_pending_prepared_streaming(*s.stmt, &pendingRes)
+	} else {
+		state = C.duckdb_pending_prepared(*s.stmt, &pendingRes)
+	}
+	if state == C.DuckDBError {
 		dbErr := getDuckDBError(C.GoString(C.duckdb_pending_error(pendingRes)))
 		C.duckdb_destroy_pending(&pendingRes)
 		return nil, dbErr
 	}
 	defer C.duckdb_destroy_pending(&pendingRes)
 
-	mainDoneCh := make(chan struct{})
-	bgDoneCh := make(chan struct{})
-	go func() {
-		select {
-		case <-ctx.Done():
-			C.duckdb_interrupt(s.c.duckdbCon)
-			close(bgDoneCh)
-			return
-		case <-mainDoneCh:
-			close(bgDoneCh)
-			return
-		}
-	}()
-
 	var res C.duckdb_result
-	state := C.duckdb_execute_pending(pendingRes, &res)
-	close(mainDoneCh)
-	// also wait for background goroutine to finish
-	// sometimes the bg goroutine is not scheduled immediately and by that time if another query is running on this connection
-	// it can cancel that query so need to wait for it to finish as well
-	<-bgDoneCh
+	s.run(ctx, func() {
+		state = C.duckdb_execute_pending(pendingRes, &res)
+	})
 	if state == C.DuckDBError {
 		if ctx.Err() != nil {
 			C.duckdb_destroy_result(&res)
@@ -279,4 +635,9 @@ func argsToNamedArgs(values []driver.Value) []driver.NamedValue {
 	return args
 }
 
-var errCouldNotBind = errors.New("could not bind parameter")
+var (
+	errCouldNotBind    = errors.New("could not bind parameter")
+	errMixedNamedArgs  = fmt.Errorf("%w: cannot mix named and positional arguments", errCouldNotBind)
+	errUnknownNamedArg = fmt.Errorf("%w: no parameter matches the named argument", errCouldNotBind)
+	errMapValue        = fmt.Errorf("%w: could not create MAP value", errCouldNotBind)
+)
@@ -3,8 +3,11 @@ package duckdb
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"errors"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -76,6 +79,36 @@ func TestPrepareQueryNamed(t *testing.T) {
 	require.NoError(t, db.Close())
 }
 
+func TestNamedParameters(t *testing.T) {
+	db := openDB(t)
+	createFooTable(db, t)
+	ctx := context.Background()
+
+	_, err := db.ExecContext(ctx, `INSERT INTO foo VALUES ($bar, $baz), ($bar, $baz + 1)`,
+		sql.Named("baz", 1), sql.Named("bar", "named"))
+	require.NoError(t, err)
+
+	// Reuse the same named parameter twice.
+	var count int
+	err = db.QueryRowContext(ctx, `SELECT COUNT(*) FROM foo WHERE bar = $bar AND baz BETWEEN $baz AND $baz + 1`,
+		sql.Named("bar", "named"), sql.Named("baz", 1)).Scan(&count)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	// Mixing named and positional arguments.
+	_, err = db.ExecContext(ctx, `SELECT $bar, $baz`, "named", sql.Named("baz", 1))
+	require.ErrorIs(t, err, errMixedNamedArgs)
+
+	// Named arguments without a matching parameter.
+	_, err = db.ExecContext(ctx, `SELECT $bar, $baz`, sql.Named("bar", "named"), sql.Named("other", 1))
+	require.ErrorIs(t, err, errUnknownNamedArg)
+	require.ErrorContains(t, err, "other")
+	_, err = db.ExecContext(ctx, `SELECT ?`, sql.Named("bar", "named"))
+	require.ErrorIs(t, err, errUnknownNamedArg)
+
+	require.NoError(t, db.Close())
+}
+
 func TestPrepareWithError(t *testing.T) {
 	db := openDB(t)
 	createFooTable(db, t)
@@ -158,3 +191,514 @@ func TestPreparePivot(t *testing.T) {
 	require.NoError(t, c.Close())
 	require.NoError(t, db.Close())
 }
+
+func TestPrepareBound(t *testing.T) {
+	db := openDB(t)
+	ctx := context.Background()
+	createTable(db, t, `CREATE TABLE counts (id INT PRIMARY KEY, name VARCHAR, n BIGINT)`)
+
+	c, err := db.Conn(ctx)
+	require.NoError(t, err)
+
+	err = c.Raw(func(driverConn any) error {
+		conn := driverConn.(*Conn)
+		s, err := conn.PrepareContext(ctx, `
+			INSERT INTO counts VALUES (?, ?, 1)
+			ON CONFLICT (id) DO UPDATE SET n = n + 1`)
+		require.NoError(t, err)
+		stmt := s.(*Stmt)
+
+		// Inspect the parameters.
+		require.Equal(t, 2, stmt.NumInput())
+		name, err := stmt.ParamName(1)
+		require.NoError(t, err)
+		require.Equal(t, "", name)
+
+		paramType, err := stmt.ParamType(1)
+		require.NoError(t, err)
+		require.Equal(t, TYPE_INTEGER, paramType)
+		paramType, err = stmt.ParamType(2)
+		require.NoError(t, err)
+		require.Equal(t, TYPE_VARCHAR, paramType)
+
+		_, err = stmt.ParamType(0)
+		testError(t, err, errAPI.Error(), paramIndexErrMsg)
+		_, err = stmt.ParamName(3)
+		testError(t, err, errAPI.Error(), paramIndexErrMsg)
+
+		// Reuse the statement for multiple parameter sets.
+		for _, id := range []int32{1, 2, 1, 1} {
+			require.NoError(t, stmt.Bind([]driver.NamedValue{{Ordinal: 1, Value: id}, {Ordinal: 2, Value: "x"}}))
+			res, err := stmt.ExecBound(ctx)
+			require.NoError(t, err)
+			ra, err := res.RowsAffected()
+			require.NoError(t, err)
+			require.Equal(t, int64(1), ra)
+		}
+		require.NoError(t, stmt.Close())
+
+		_, err = stmt.ParamType(1)
+		testError(t, err, errAPI.Error(), errClosedStmt.Error())
+		testError(t, stmt.Bind(nil), errAPI.Error(), errClosedStmt.Error())
+		_, err = stmt.ExecBound(ctx)
+		testError(t, err, errAPI.Error(), errClosedStmt.Error())
+
+		// Query a bound statement with a named parameter.
+		s, err = conn.PrepareContext(ctx, `SELECT n FROM counts WHERE id = $id`)
+		require.NoError(t, err)
+		stmt = s.(*Stmt)
+
+		name, err = stmt.ParamName(1)
+		require.NoError(t, err)
+		require.Equal(t, "id", name)
+
+		require.NoError(t, stmt.Bind([]driver.NamedValue{{Name: "id", Value: int32(1)}}))
+		rows, err := stmt.QueryBound(ctx)
+		require.NoError(t, err)
+		values := make([]driver.Value, 1)
+		require.NoError(t, rows.Next(values))
+		require.Equal(t, int64(3), values[0])
+
+		// The statement cannot execute while its rows are open.
+		_, err = stmt.ExecBound(ctx)
+		testError(t, err, errAPI.Error(), errActiveRows.Error())
+		_, err = stmt.QueryBound(ctx)
+		testError(t, err, errAPI.Error(), errActiveRows.Error())
+		require.NoError(t, rows.Close())
+		return stmt.Close()
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, c.Close())
+	require.NoError(t, db.Close())
+}
+
+func TestParamTypes(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	ctx := context.Background()
+	createTable(db, t, `CREATE TABLE params (i INTEGER, v VARCHAR, d DECIMAL(10, 2), l INTEGER[], ts TIMESTAMP_NS)`)
+
+	c, err := db.Conn(ctx)
+	require.NoError(t, err)
+
+	err = c.Raw(func(driverConn any) error {
+		conn := driverConn.(*Conn)
+		s, err := conn.PrepareContext(ctx, `INSERT INTO params VALUES (?, ?, ?, ?, ?)`)
+		require.NoError(t, err)
+		stmt := s.(*Stmt)
+
+		types, err := stmt.ParamTypes()
+		require.NoError(t, err)
+		require.Len(t, types, 5)
+		require.Equal(t, "INTEGER", types[0].String())
+		require.Equal(t, "VARCHAR", types[1].String())
+		require.Equal(t, "TIMESTAMP_NS", types[4].String())
+
+		// Types with type parameters are unknown, but ParamType returns their Type.
+		require.Equal(t, TYPE_INVALID, types[2].InternalType())
+		require.Equal(t, TYPE_INVALID, types[3].InternalType())
+		paramType, err := stmt.ParamType(3)
+		require.NoError(t, err)
+		require.Equal(t, TYPE_DECIMAL, paramType)
+		require.NoError(t, stmt.Close())
+
+		// DuckDB cannot infer the type of an untyped parameter.
+		s, err = conn.PrepareContext(ctx, `SELECT ?`)
+		require.NoError(t, err)
+		stmt = s.(*Stmt)
+		types, err = stmt.ParamTypes()
+		require.NoError(t, err)
+		require.Len(t, types, 1)
+		require.Equal(t, TYPE_INVALID, types[0].InternalType())
+		require.NoError(t, stmt.Close())
+
+		_, err = stmt.ParamTypes()
+		testError(t, err, errAPI.Error(), errClosedStmt.Error())
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, c.Close())
+	require.NoError(t, db.Close())
+}
+
+func TestStmtInterrupt(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	ctx := context.Background()
+
+	c, err := db.Conn(ctx)
+	require.NoError(t, err)
+
+	err = c.Raw(func(driverConn any) error {
+		conn := driverConn.(*Conn)
+		s, err := conn.PrepareContext(ctx, `SELECT count(*) FROM range(10000000000) t1, range(1000000) t2`)
+		require.NoError(t, err)
+		stmt := s.(*Stmt)
+
+		// Interrupting a statement that is not executing is a no-op.
+		stmt.Interrupt()
+
+		errCh := make(chan error)
+		go func() {
+			_, err := stmt.ExecContext(ctx, nil)
+			errCh <- err
+		}()
+
+		// Interrupt the statement until its execution returns.
+		var execErr error
+		for done := false; !done; {
+			select {
+			case execErr = <-errCh:
+				done = true
+			case <-time.After(10 * time.Millisecond):
+				stmt.Interrupt()
+			}
+		}
+		var dbErr *Error
+		require.ErrorAs(t, execErr, &dbErr)
+		require.Equal(t, ErrorTypeInterrupt, dbErr.Type)
+
+		// Interrupting a completed statement does not affect the next query.
+		stmt.Interrupt()
+		require.NoError(t, stmt.Close())
+
+		s, err = conn.PrepareContext(ctx, `SELECT 42`)
+		require.NoError(t, err)
+		stmt = s.(*Stmt)
+		rows, err := stmt.QueryContext(ctx, nil)
+		require.NoError(t, err)
+		stmt.Interrupt()
+		values := make([]driver.Value, 1)
+		require.NoError(t, rows.Next(values))
+		require.Equal(t, int32(42), values[0])
+		require.NoError(t, rows.Close())
+		return stmt.Close()
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, c.Close())
+	require.NoError(t, db.Close())
+}
+
+func TestBindList(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	_, err := db.Exec(`CREATE TABLE items AS SELECT range AS id FROM range(10)`)
+	require.NoError(t, err)
+
+	// A slice binds as a LIST value, e.g., for IN and ANY lists.
+	var ids []int64
+	rows, err := db.Query(`SELECT id FROM items WHERE id IN (SELECT unnest(?)) ORDER BY id`, []int{2, 4, 6})
+	require.NoError(t, err)
+	for rows.Next() {
+		var id int64
+		require.NoError(t, rows.Scan(&id))
+		ids = append(ids, id)
+	}
+	require.NoError(t, rows.Err())
+	require.NoError(t, rows.Close())
+	require.Equal(t, []int64{2, 4, 6}, ids)
+
+	var count int
+	require.NoError(t, db.QueryRow(`SELECT count(*) FROM items WHERE id = ANY(?)`, []int32{1, 3, 100}).Scan(&count))
+	require.Equal(t, 2, count)
+
+	// The Go element type determines the element type, also of empty slices.
+	tests := []struct {
+		v    any
+		typ  string
+		text string
+	}{
+		{v: []int32{}, typ: "INTEGER[]", text: "[]"},
+		{v: []string{"a", "b"}, typ: "VARCHAR[]", text: "[a, b]"},
+		{v: [][]float64{{1.5}, {}}, typ: "DOUBLE[][]", text: "[[1.5], []]"},
+		{v: [2]bool{true, false}, typ: "BOOLEAN[2]", text: "[true, false]"},
+		{v: [][]byte{[]byte("x")}, typ: "BLOB[]", text: "[x]"},
+		{v: []*big.Int{big.NewInt(-1)}, typ: "HUGEINT[]", text: "[-1]"},
+		{v: []Interval{{Days: 1}}, typ: "INTERVAL[]", text: "[1 day]"},
+		{v: []time.Time{time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)}, typ: "TIMESTAMP[]", text: "[2024-01-02 03:04:05]"},
+		{v: []UUID{{15: 1}}, typ: "UUID[]", text: "[00000000-0000-0000-0000-000000000001]"},
+	}
+	for _, test := range tests {
+		var typ, text string
+		require.NoError(t, db.QueryRow(`SELECT typeof($1), $1::VARCHAR`, test.v).Scan(&typ, &text))
+		require.Equal(t, test.typ, typ)
+		require.Equal(t, test.text, text)
+	}
+
+	// Lists scan back into typed slices.
+	var l List[[]string]
+	require.NoError(t, db.QueryRow(`SELECT ?`, [][]string{{"a"}, {"b", "c"}}).Scan(&l))
+	require.Equal(t, [][]string{{"a"}, {"b", "c"}}, l.Get())
+
+	// Byte slices still bind as BLOB values.
+	var blob []byte
+	require.NoError(t, db.QueryRow(`SELECT ?`, []byte("abc")).Scan(&blob))
+	require.Equal(t, []byte("abc"), blob)
+
+	// Pointer and interface elements are unsupported, as the C API cannot create NULL elements.
+	err = db.QueryRow(`SELECT ?`, []*int{nil}).Scan(&l)
+	require.ErrorContains(t, err, unsupportedTypeErrMsg+": *int")
+	err = db.QueryRow(`SELECT ?`, []any{1}).Scan(&l)
+	require.ErrorContains(t, err, unsupportedTypeErrMsg+": interface {}")
+
+	require.NoError(t, db.Close())
+}
+
+func TestStatementType(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	require.NoError(t, err)
+
+	err = conn.Raw(func(driverConn any) error {
+		c := driverConn.(*Conn)
+
+		// Prepared statements have a type before executing them.
+		tests := []struct {
+			query string
+			typ   StatementType
+		}{
+			{`CREATE TABLE users (id INTEGER)`, STATEMENT_TYPE_CREATE},
+			{`INSERT INTO users VALUES (1), (2)`, STATEMENT_TYPE_INSERT},
+			{`UPDATE users SET id = id + 1`, STATEMENT_TYPE_UPDATE},
+			{`SELECT * FROM users`, STATEMENT_TYPE_SELECT},
+			{`DELETE FROM users WHERE id = 3`, STATEMENT_TYPE_DELETE},
+			{`SET threads = 1`, STATEMENT_TYPE_SET},
+			{`DROP TABLE users`, STATEMENT_TYPE_DROP},
+		}
+		for _, test := range tests {
+			s, err := c.PrepareContext(ctx, test.query)
+			require.NoError(t, err)
+			stmt := s.(*Stmt)
+			require.Equal(t, test.typ, stmt.StatementType(), test.query)
+
+			res, err := stmt.ExecContext(ctx, nil)
+			require.NoError(t, err)
+			require.Equal(t, test.typ, res.(StatementTyper).StatementType(), test.query)
+			require.NoError(t, stmt.Close())
+			require.Equal(t, STATEMENT_TYPE_INVALID, stmt.StatementType())
+		}
+
+		// The result of the last statement determines the type.
+		res, err := c.ExecContext(ctx, `CREATE TABLE items (id INTEGER); INSERT INTO items VALUES (1)`, nil)
+		require.NoError(t, err)
+		require.Equal(t, STATEMENT_TYPE_INSERT, res.(StatementTyper).StatementType())
+
+		r, err := c.QueryContext(ctx, `INSERT INTO items VALUES (2) RETURNING id`, nil)
+		require.NoError(t, err)
+		require.Equal(t, STATEMENT_TYPE_INSERT, r.(StatementTyper).StatementType())
+		require.NoError(t, r.Close())
+
+		r, err = c.QueryContext(ctx, `SELECT * FROM items`, nil)
+		require.NoError(t, err)
+		require.Equal(t, STATEMENT_TYPE_SELECT, r.(StatementTyper).StatementType())
+		return r.Close()
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, conn.Close())
+	require.NoError(t, db.Close())
+}
+
+func TestBindTime(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	createTable(db, t, `CREATE TABLE times (d DATE, t TIME, ttz TIMETZ, ts TIMESTAMP, s TIMESTAMP_S,
+		ms TIMESTAMP_MS, ns TIMESTAMP_NS, tstz TIMESTAMPTZ)`)
+
+	// The parameter types determine the encoding of the time.Time values.
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 123456789, time.FixedZone("", 2*60*60))
+	_, err := db.Exec(`INSERT INTO times VALUES (?, ?, ?, ?, ?, ?, ?, ?)`, ts, ts, ts, ts, ts, ts, ts, ts)
+	require.NoError(t, err)
+
+	expected := []string{
+		"2024-01-02", "01:04:05.123456", "03:04:05.123456+02", "2024-01-02 01:04:05.123456", "2024-01-02 01:04:05",
+		"2024-01-02 01:04:05.123", "2024-01-02 01:04:05.123456789", "2024-01-02 01:04:05.123456+00",
+	}
+	columns := []string{"d", "t", "ttz", "ts", "s", "ms", "ns", "tstz"}
+	for i, column := range columns {
+		var text string
+		require.NoError(t, db.QueryRow(`SELECT `+column+`::VARCHAR FROM times`).Scan(&text))
+		require.Equal(t, expected[i], text, column)
+
+		// Comparisons bind the parameter with the column's type.
+		var count int
+		require.NoError(t, db.QueryRow(`SELECT count(*) FROM times WHERE `+column+` = ?`, ts).Scan(&count))
+		require.Equal(t, 1, count, column)
+	}
+
+	// Dates before 1970 round down to the previous day.
+	var date time.Time
+	before := time.Date(1969, 12, 31, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, db.QueryRow(`INSERT INTO times (d) VALUES (?) RETURNING d`, before).Scan(&date))
+	require.Equal(t, time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC), date)
+
+	require.NoError(t, db.Close())
+}
+
+func TestBindCustomTypes(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+
+	d := Decimal{Width: 5, Scale: 2, Value: big.NewInt(-1234)}
+	i := Interval{Days: 2, Micros: 1000}
+	l := []int32{1, 2}
+	p := struct {
+		X int32 `db:"x"`
+	}{X: 1}
+	m := map[string]bool{"a": true}
+
+	// The driver's types, and pointers to them, bind without converting them to strings first.
+	tests := []struct {
+		v    any
+		typ  string
+		text string
+	}{
+		{v: d, typ: "DECIMAL(5,2)", text: "-12.34"},
+		{v: &d, typ: "DECIMAL(5,2)", text: "-12.34"},
+		{v: NullDecimal{Decimal: d, Valid: true}, typ: "DECIMAL(5,2)", text: "-12.34"},
+		{v: &NullDecimal{Decimal: d, Valid: true}, typ: "DECIMAL(5,2)", text: "-12.34"},
+		{v: i, typ: "INTERVAL", text: "2 days 00:00:00.001"},
+		{v: &i, typ: "INTERVAL", text: "2 days 00:00:00.001"},
+		{v: &NullInterval{Interval: i, Valid: true}, typ: "INTERVAL", text: "2 days 00:00:00.001"},
+		{v: big.NewInt(42), typ: "HUGEINT", text: "42"},
+		{v: l, typ: "INTEGER[]", text: "[1, 2]"},
+		{v: &l, typ: "INTEGER[]", text: "[1, 2]"},
+		{v: &p, typ: "STRUCT(x INTEGER)", text: "{'x': 1}"},
+		{v: &m, typ: `STRUCT("key" VARCHAR, "value" BOOLEAN)[]`, text: "[{'key': a, 'value': true}]"},
+	}
+	for _, test := range tests {
+		var typ, text string
+		require.NoError(t, db.QueryRow(`SELECT typeof($1), $1::VARCHAR`, test.v).Scan(&typ, &text), test.typ)
+		require.Equal(t, test.typ, typ)
+		require.Equal(t, test.text, text)
+	}
+
+	// UUID values bind as their string representation.
+	u := UUID{15: 1}
+	var uuid UUID
+	require.NoError(t, db.QueryRow(`SELECT ?::UUID`, &u).Scan(&uuid))
+	require.Equal(t, u, uuid)
+
+	// Invalid values and nil pointers bind as NULL.
+	for _, v := range []any{NullDecimal{}, (*NullDecimal)(nil), (*Interval)(nil), &NullInterval{}, (*[]int32)(nil)} {
+		var isNull bool
+		require.NoError(t, db.QueryRow(`SELECT ? IS NULL`, v).Scan(&isNull))
+		require.True(t, isNull, "%T", v)
+	}
+
+	require.NoError(t, db.Close())
+}
+
+func TestBindNilPointers(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	_, err := db.Exec(`CREATE TABLE test (i INTEGER, s VARCHAR, f DOUBLE, b BOOLEAN, ts TIMESTAMP, h HUGEINT,
+		d DECIMAL(5, 2), iv INTERVAL, u UUID, l INTEGER[])`)
+	require.NoError(t, err)
+
+	// Typed nil pointers bind NULL, also for the types of the driver.
+	args := []any{(*int32)(nil), (*string)(nil), (*float64)(nil), (*bool)(nil), (*time.Time)(nil), (*big.Int)(nil),
+		(*Decimal)(nil), (*Interval)(nil), (*UUID)(nil), (*[]int32)(nil)}
+	_, err = db.Exec(`INSERT INTO test VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`, args...)
+	require.NoError(t, err)
+
+	var count int
+	err = db.QueryRow(`SELECT count(*) FROM test WHERE i IS NULL AND s IS NULL AND f IS NULL AND b IS NULL
+		AND ts IS NULL AND h IS NULL AND d IS NULL AND iv IS NULL AND u IS NULL AND l IS NULL`).Scan(&count)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	// Parameters without a type, too.
+	for _, arg := range append(args, (*NullDecimal)(nil), (**int)(nil), (*map[string]int)(nil)) {
+		var isNull bool
+		require.NoError(t, db.QueryRow(`SELECT ? IS NULL`, arg).Scan(&isNull))
+		require.True(t, isNull, "%T", arg)
+	}
+
+	// Binding on the driver connection skips the conversion of database/sql.
+	conn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	err = conn.Raw(func(driverConn any) error {
+		_, err := driverConn.(*Conn).ExecContext(context.Background(), `INSERT INTO test (i, h) VALUES ($1, $2)`,
+			[]driver.NamedValue{{Ordinal: 1, Value: (*int32)(nil)}, {Ordinal: 2, Value: (*big.Int)(nil)}})
+		return err
+	})
+	require.NoError(t, err)
+	require.NoError(t, conn.QueryRowContext(context.Background(), `SELECT count(*) FROM test WHERE i IS NULL AND h IS NULL`).Scan(&count))
+	require.Equal(t, 2, count)
+
+	require.NoError(t, conn.Close())
+	require.NoError(t, db.Close())
+}
+
+func TestBindStruct(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	_, err := db.Exec(`CREATE TABLE points (id INTEGER, p STRUCT(x INTEGER, y INTEGER, tags VARCHAR[]), m MAP(VARCHAR, INTEGER))`)
+	require.NoError(t, err)
+
+	type point struct {
+		X      int32    `db:"x"`
+		Y      int32    `db:"y"`
+		Tags   []string `db:"tags"`
+		hidden string
+	}
+
+	// A struct binds as a STRUCT value, e.g., for inserts and comparisons.
+	p := point{X: 1, Y: 2, Tags: []string{"a"}, hidden: "h"}
+	_, err = db.Exec(`INSERT INTO points VALUES (1, ?, NULL)`, p)
+	require.NoError(t, err)
+
+	var id int32
+	require.NoError(t, db.QueryRow(`SELECT id FROM points WHERE p = ?`, p).Scan(&id))
+	require.Equal(t, int32(1), id)
+
+	var res Struct[point]
+	require.NoError(t, db.QueryRow(`SELECT p FROM points`).Scan(&res))
+	require.Equal(t, point{X: 1, Y: 2, Tags: []string{"a"}}, res.Get())
+
+	var typ string
+	require.NoError(t, db.QueryRow(`SELECT typeof(?)`, struct {
+		Name  string
+		Inner struct{ N []float32 }
+	}{}).Scan(&typ))
+	require.Equal(t, `STRUCT("Name" VARCHAR, "Inner" STRUCT(N FLOAT[]))`, typ)
+
+	// A map binds as a LIST of its STRUCT(key, value) entries, which map_from_entries converts to a MAP.
+	m := map[string]int32{"a": 1, "b": 2}
+	_, err = db.Exec(`UPDATE points SET m = map_from_entries(?)`, m)
+	require.NoError(t, err)
+	var scanned TypedMap[string, int32]
+	require.NoError(t, db.QueryRow(`SELECT m FROM points`).Scan(&scanned))
+	require.Equal(t, m, scanned.Get())
+
+	// A map binds as a MAP value, if the parameter type is a MAP.
+	m = map[string]int32{"c": 3}
+	_, err = db.Exec(`UPDATE points SET m = ?`, m)
+	require.NoError(t, err)
+	require.NoError(t, db.QueryRow(`SELECT m FROM points`).Scan(&scanned))
+	require.Equal(t, m, scanned.Get())
+
+	var n int
+	require.NoError(t, db.QueryRow(`SELECT cardinality(?::MAP(VARCHAR, INTEGER))`, map[string]int32{"a": 1, "b": 2}).Scan(&n))
+	require.Equal(t, 2, n)
+	require.NoError(t, db.QueryRow(`SELECT cardinality(?::MAP(VARCHAR, INTEGER))`, map[string]int32{}).Scan(&n))
+	require.Zero(t, n)
+
+	// The value type must match the parameter type.
+	_, err = db.Exec(`UPDATE points SET id = ?`, p)
+	require.ErrorContains(t, err, typeInfoMismatchErrMsg+": expected INTEGER, got STRUCT")
+
+	// Structs without exported fields are unsupported.
+	err = db.QueryRow(`SELECT ?`, struct{ hidden int }{}).Scan(&typ)
+	require.ErrorContains(t, err, unsupportedTypeErrMsg)
+	err = db.QueryRow(`SELECT ?`, struct{ P *point }{}).Scan(&typ)
+	require.ErrorContains(t, err, unsupportedTypeErrMsg+": *duckdb.point: field: P")
+
+	require.NoError(t, db.Close())
+}
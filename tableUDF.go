@@ -306,7 +306,7 @@ func table_udf_row_callback(info C.duckdb_function_info, output C.duckdb_data_ch
 	var chunk DataChunk
 	err := chunk.initFromDuckDataChunk(output, true)
 	if err != nil {
-		setFuncError(info, err.Error())
+		setTableFuncError(info, err.Error())
 		return
 	}
 
@@ -322,7 +322,7 @@ func table_udf_row_callback(info C.duckdb_function_info, output C.duckdb_data_ch
 		for row.r = 0; row.r < maxSize; row.r++ {
 			next, errRow := fun.FillRow(row)
 			if errRow != nil {
-				setFuncError(info, errRow.Error())
+				setTableFuncError(info, errRow.Error())
 				break
 			}
 			if !next {
@@ -335,7 +335,7 @@ func table_udf_row_callback(info C.duckdb_function_info, output C.duckdb_data_ch
 		for row.r = 0; row.r < maxSize; row.r++ {
 			next, errRow := fun.FillRow(localState, row)
 			if errRow != nil {
-				setFuncError(info, errRow.Error())
+				setTableFuncError(info, errRow.Error())
 				break
 			}
 			if !next {
@@ -353,7 +353,7 @@ func table_udf_chunk_callback(info C.duckdb_function_info, output C.duckdb_data_
 	var chunk DataChunk
 	err := chunk.initFromDuckDataChunk(output, true)
 	if err != nil {
-		setFuncError(info, err.Error())
+		setTableFuncError(info, err.Error())
 		return
 	}
 
@@ -365,7 +365,7 @@ func table_udf_chunk_callback(info C.duckdb_function_info, output C.duckdb_data_
 		err = fun.FillChunk(localState, chunk)
 	}
 	if err != nil {
-		setFuncError(info, err.Error())
+		setTableFuncError(info, err.Error())
 	}
 }
 
@@ -3,8 +3,11 @@ package duckdb
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
+	"errors"
 	"fmt"
 	"math/big"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -778,3 +781,224 @@ func BenchmarkChunkTableUDF(b *testing.B) {
 	require.NoError(b, con.Close())
 	require.NoError(b, db.Close())
 }
+
+type sliceRowIterator struct {
+	rows   [][]driver.Value
+	row    int
+	err    error
+	closed int
+}
+
+func (it *sliceRowIterator) Next() bool {
+	if it.row >= len(it.rows) {
+		return false
+	}
+	it.row++
+	return true
+}
+
+func (it *sliceRowIterator) Values() []driver.Value {
+	return it.rows[it.row-1]
+}
+
+func (it *sliceRowIterator) Err() error {
+	return it.err
+}
+
+func (it *sliceRowIterator) Close() {
+	it.closed++
+}
+
+func TestRowIteratorTableFunction(t *testing.T) {
+	connector, err := NewConnector("", nil)
+	require.NoError(t, err)
+	defer connector.Close()
+
+	// Resolve tables prefixed with stream_ with the iterator table function.
+	RegisterReplacementScan(connector, func(tableName string) (string, []any, error) {
+		if name, ok := strings.CutPrefix(tableName, "stream_"); ok {
+			return "iter", []any{name}, nil
+		}
+		return "", nil, nil
+	})
+
+	db := sql.OpenDB(connector)
+	defer db.Close()
+	conn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	bigintInfo, err := NewTypeInfo(TYPE_BIGINT)
+	require.NoError(t, err)
+	varcharInfo, err := NewTypeInfo(TYPE_VARCHAR)
+	require.NoError(t, err)
+
+	var iters []*sliceRowIterator
+	f := RowIteratorTableFunction(
+		TableFunctionConfig{Arguments: []TypeInfo{varcharInfo}},
+		[]ColumnInfo{{Name: "id", T: bigintInfo}, {Name: "name", T: varcharInfo}},
+		func(named map[string]any, args ...any) (RowIterator, error) {
+			it := &sliceRowIterator{}
+			switch args[0].(string) {
+			case "failing":
+				it.err = errors.New("source failed")
+			case "short":
+				it.rows = [][]driver.Value{{int64(1)}}
+			case "missing":
+				return nil, errors.New("unknown source")
+			default:
+				it.rows = [][]driver.Value{{int64(1), "a"}, {int64(2), nil}, {nil, "c"}}
+			}
+			iters = append(iters, it)
+			return it, nil
+		})
+	require.NoError(t, RegisterTableUDF(conn, "iter", f))
+
+	// NULL values, and the projection of the columns.
+	for _, query := range []string{`SELECT name FROM iter('rows')`, `SELECT name FROM stream_rows`} {
+		res, err := conn.QueryContext(context.Background(), query)
+		require.NoError(t, err)
+		var names []*string
+		for res.Next() {
+			var name *string
+			require.NoError(t, res.Scan(&name))
+			names = append(names, name)
+		}
+		require.NoError(t, res.Err())
+		require.NoError(t, res.Close())
+		require.Len(t, names, 3)
+		require.Equal(t, "a", *names[0])
+		require.Nil(t, names[1])
+		require.Equal(t, "c", *names[2])
+		require.Equal(t, 1, iters[len(iters)-1].closed)
+	}
+
+	var count, sum int64
+	err = conn.QueryRowContext(context.Background(), `SELECT count(*), sum(id) FROM stream_rows`).Scan(&count, &sum)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), count)
+	require.Equal(t, int64(3), sum)
+
+	// Closing the result before the last row closes the iterator.
+	var id int64
+	require.NoError(t, conn.QueryRowContext(context.Background(), `SELECT id FROM iter('rows') LIMIT 1`).Scan(&id))
+	require.Equal(t, int64(1), id)
+	require.Equal(t, 1, iters[len(iters)-1].closed)
+
+	// The iterator fails.
+	_, err = conn.ExecContext(context.Background(), `SELECT * FROM iter('failing')`)
+	require.ErrorContains(t, err, "source failed")
+
+	// The iterator returns fewer values than columns.
+	_, err = conn.ExecContext(context.Background(), `SELECT * FROM iter('short')`)
+	testError(t, err, errAPI.Error(), columnCountErrMsg)
+
+	// The iterator cannot be opened.
+	_, err = conn.ExecContext(context.Background(), `SELECT * FROM stream_missing`)
+	require.ErrorContains(t, err, "unknown source")
+
+	for _, it := range iters {
+		require.Equal(t, 1, it.closed)
+	}
+}
+
+type (
+	projectedTableUDF struct {
+		n     int64
+		count int64
+	}
+
+	failingRowTableUDF struct{}
+
+	failingChunkTableUDF struct{}
+)
+
+func (udf *projectedTableUDF) ColumnInfos() []ColumnInfo {
+	return []ColumnInfo{{Name: "a", T: typeBigintTableUDF}, {Name: "b", T: typeBigintTableUDF}}
+}
+
+func (udf *projectedTableUDF) Init() {}
+
+func (udf *projectedTableUDF) FillRow(row Row) (bool, error) {
+	if udf.count >= udf.n {
+		return false, nil
+	}
+	udf.count++
+	if err := row.SetRowValue(0, udf.count); err != nil {
+		return false, err
+	}
+	return true, row.SetRowValue(1, udf.count*10)
+}
+
+func (udf *projectedTableUDF) Cardinality() *CardinalityInfo {
+	return nil
+}
+
+func (udf *failingRowTableUDF) ColumnInfos() []ColumnInfo {
+	return []ColumnInfo{{Name: "a", T: typeBigintTableUDF}}
+}
+
+func (udf *failingRowTableUDF) Init() {}
+
+func (udf *failingRowTableUDF) FillRow(Row) (bool, error) {
+	return false, errors.New("row failure")
+}
+
+func (udf *failingRowTableUDF) Cardinality() *CardinalityInfo {
+	return nil
+}
+
+func (udf *failingChunkTableUDF) ColumnInfos() []ColumnInfo {
+	return []ColumnInfo{{Name: "a", T: typeBigintTableUDF}}
+}
+
+func (udf *failingChunkTableUDF) Init() {}
+
+func (udf *failingChunkTableUDF) FillChunk(DataChunk) error {
+	return errors.New("chunk failure")
+}
+
+func (udf *failingChunkTableUDF) Cardinality() *CardinalityInfo {
+	return nil
+}
+
+func TestTableUDFProjectionAndErrors(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	conn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+
+	err = RegisterTableUDF(conn, "projected", RowTableFunction{
+		Config: TableFunctionConfig{Arguments: []TypeInfo{typeBigintTableUDF}},
+		BindArguments: func(named map[string]any, args ...any) (RowTableSource, error) {
+			return &projectedTableUDF{n: args[0].(int64)}, nil
+		},
+	})
+	require.NoError(t, err)
+	err = RegisterTableUDF(conn, "failing_row", RowTableFunction{
+		BindArguments: func(named map[string]any, args ...any) (RowTableSource, error) {
+			return &failingRowTableUDF{}, nil
+		},
+	})
+	require.NoError(t, err)
+	err = RegisterTableUDF(conn, "failing_chunk", ChunkTableFunction{
+		BindArguments: func(named map[string]any, args ...any) (ChunkTableSource, error) {
+			return &failingChunkTableUDF{}, nil
+		},
+	})
+	require.NoError(t, err)
+
+	// Row.SetRowValue writes to the projected columns.
+	var sum int64
+	require.NoError(t, conn.QueryRowContext(context.Background(), `SELECT sum(b) FROM projected(3)`).Scan(&sum))
+	require.Equal(t, int64(60), sum)
+
+	// Errors of the table sources fail the query.
+	_, err = conn.ExecContext(context.Background(), `SELECT * FROM failing_row()`)
+	require.ErrorContains(t, err, "row failure")
+	_, err = conn.ExecContext(context.Background(), `SELECT * FROM failing_chunk()`)
+	require.ErrorContains(t, err, "chunk failure")
+
+	require.NoError(t, conn.Close())
+	require.NoError(t, db.Close())
+}
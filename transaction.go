@@ -1,6 +1,9 @@
 package duckdb
 
-import "context"
+import (
+	"context"
+	"errors"
+)
 
 type tx struct {
 	c *Conn
@@ -12,6 +15,12 @@ func (t *tx) Commit() error {
 	}
 
 	t.c.tx = false
+	// Roll back the transaction, if an appender fails to flush its rows.
+	if errFlush := t.c.endTxAppenders(true); errFlush != nil {
+		_, err := t.c.ExecContext(context.Background(), "ROLLBACK", nil)
+		t.c = nil
+		return errors.Join(errFlush, err)
+	}
 	_, err := t.c.ExecContext(context.Background(), "COMMIT TRANSACTION", nil)
 	t.c = nil
 
@@ -24,8 +33,19 @@ func (t *tx) Rollback() error {
 	}
 
 	t.c.tx = false
+	_ = t.c.endTxAppenders(false)
 	_, err := t.c.ExecContext(context.Background(), "ROLLBACK", nil)
 	t.c = nil
 
 	return err
 }
+
+// endTxAppenders ends the transaction of the appenders created in it.
+func (c *Conn) endTxAppenders(commit bool) error {
+	var err error
+	for _, a := range c.txAppenders {
+		err = errors.Join(err, a.endTx(commit))
+	}
+	c.txAppenders = nil
+	return err
+}
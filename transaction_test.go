@@ -0,0 +1,63 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransaction(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	_, err := db.Exec(`CREATE TABLE test (v INT)`)
+	require.NoError(t, err)
+
+	count := func() int {
+		var n int
+		require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM test`).Scan(&n))
+		return n
+	}
+
+	// Commit.
+	tx, err := db.Begin()
+	require.NoError(t, err)
+	_, err = tx.Exec(`INSERT INTO test VALUES (1), (2)`)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+	require.Equal(t, 2, count())
+
+	// Rollback.
+	tx, err = db.Begin()
+	require.NoError(t, err)
+	_, err = tx.Exec(`INSERT INTO test VALUES (3)`)
+	require.NoError(t, err)
+	require.NoError(t, tx.Rollback())
+	require.Equal(t, 2, count())
+
+	// DuckDB does not support savepoints, so nested units of work must use separate transactions.
+	tx, err = db.Begin()
+	require.NoError(t, err)
+	_, err = tx.Exec(`SAVEPOINT sp`)
+	require.ErrorContains(t, err, "syntax error")
+	require.NoError(t, tx.Rollback())
+
+	require.NoError(t, db.Close())
+}
+
+func TestErrBeginTx(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	ctx := context.Background()
+
+	_, err := db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	require.ErrorIs(t, err, errBeginTx)
+	require.ErrorIs(t, err, errIsolationLevelNotSupported)
+
+	_, err = db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	require.ErrorIs(t, err, errBeginTx)
+	require.ErrorIs(t, err, errReadOnlyTxNotSupported)
+
+	require.NoError(t, db.Close())
+}
@@ -50,12 +50,8 @@ const (
 
 // FIXME: Implement support for these types.
 var unsupportedTypeToStringMap = map[Type]string{
-	TYPE_INVALID:  "INVALID",
-	TYPE_UHUGEINT: "UHUGEINT",
-	TYPE_UNION:    "UNION",
-	TYPE_BIT:      "BIT",
-	TYPE_ANY:      "ANY",
-	TYPE_VARINT:   "VARINT",
+	TYPE_INVALID: "INVALID",
+	TYPE_ANY:     "ANY",
 }
 
 var typeToStringMap = map[Type]string{
@@ -99,3 +95,6 @@ var typeToStringMap = map[Type]string{
 }
 
 const aliasJSON = "JSON"
+
+// aliasGeometry is the alias of the spatial extension's GEOMETRY type, which is a BLOB.
+const aliasGeometry = "GEOMETRY"
@@ -6,8 +6,12 @@ package duckdb
 import "C"
 
 import (
+	"fmt"
 	"reflect"
 	"runtime"
+	"slices"
+	"strconv"
+	"strings"
 	"unsafe"
 )
 
@@ -47,6 +51,12 @@ func (entry *structEntry) Name() string {
 	return entry.name
 }
 
+// StructField describes a STRUCT field by its name and type information.
+type StructField struct {
+	Name string
+	Type TypeInfo
+}
+
 type baseTypeInfo struct {
 	Type
 	structEntries []StructEntry
@@ -60,6 +70,8 @@ type baseTypeInfo struct {
 type vectorTypeInfo struct {
 	baseTypeInfo
 	dict map[string]uint32
+	// The ENUM names by their dictionary index, to read ENUM values without calling into the C API.
+	dictNames []string
 }
 
 type typeInfo struct {
@@ -70,22 +82,182 @@ type typeInfo struct {
 
 // TypeInfo is an interface for a DuckDB type.
 type TypeInfo interface {
-	// InternalType returns the Type.
+	// InternalType returns the Type, e.g., TYPE_LIST for a LIST, or TYPE_DECIMAL for a DECIMAL.
+	// The children of nested types are available via ChildType, KeyType, ValueType, and StructFields.
 	InternalType() Type
+	// Equals returns true, if the TypeInfo is structurally equal to other.
+	// Nested types are equal, if their children, names, and type parameters are equal.
+	Equals(other TypeInfo) bool
+	// String returns the DuckDB SQL type name, e.g., STRUCT("a" DECIMAL(3,2)[], "b" MAP(VARCHAR, INTEGER)).
+	// It is valid input for SQL statements, e.g., CREATE TABLE.
+	String() string
+	// EnumNames returns a copy of the ordered ENUM dictionary values.
+	// It returns an error, if the type is not an ENUM.
+	EnumNames() ([]string, error)
+	// EnumDictionarySize returns the number of ENUM dictionary values.
+	// It returns an error, if the type is not an ENUM.
+	EnumDictionarySize() (int, error)
+	// DecimalWidth returns the DECIMAL width, and true, if the type is a DECIMAL.
+	DecimalWidth() (uint8, bool)
+	// DecimalScale returns the DECIMAL scale, and true, if the type is a DECIMAL.
+	DecimalScale() (uint8, bool)
+	// ChildType returns the element type information of a LIST or ARRAY.
+	// It returns an error for any other type.
+	ChildType() (TypeInfo, error)
+	// KeyType returns the key type information of a MAP.
+	// It returns an error for any other type.
+	KeyType() (TypeInfo, error)
+	// ValueType returns the value type information of a MAP.
+	// It returns an error for any other type.
+	ValueType() (TypeInfo, error)
+	// StructFields returns the fields of a STRUCT in their declaration order.
+	// It returns an error for any other type.
+	StructFields() ([]StructField, error)
 	logicalType() C.duckdb_logical_type
+	details() *typeInfo
 }
 
 func (info *typeInfo) InternalType() Type {
 	return info.Type
 }
 
+func (info *typeInfo) Equals(other TypeInfo) bool {
+	if other == nil {
+		return false
+	}
+	o := other.details()
+	if info == o {
+		return true
+	}
+	if info.Type != o.Type {
+		return false
+	}
+
+	switch info.Type {
+	case TYPE_DECIMAL:
+		return info.decimalWidth == o.decimalWidth && info.decimalScale == o.decimalScale
+	case TYPE_ENUM:
+		return slices.Equal(info.enumNames, o.enumNames)
+	case TYPE_ARRAY:
+		if info.arrayLength != o.arrayLength {
+			return false
+		}
+	case TYPE_STRUCT, TYPE_UNION:
+		return slices.EqualFunc(info.structEntries, o.structEntries, func(a StructEntry, b StructEntry) bool {
+			return a.Name() == b.Name() && a.Info().Equals(b.Info())
+		})
+	}
+
+	// LIST, ARRAY, and MAP types compare their children.
+	return slices.EqualFunc(info.childTypes, o.childTypes, func(a TypeInfo, b TypeInfo) bool {
+		return a.Equals(b)
+	})
+}
+
+func (info *typeInfo) String() string {
+	switch info.Type {
+	case TYPE_DECIMAL:
+		return fmt.Sprintf("DECIMAL(%d,%d)", info.decimalWidth, info.decimalScale)
+	case TYPE_ENUM:
+		names := make([]string, 0, len(info.enumNames))
+		for _, name := range info.enumNames {
+			names = append(names, escapeEnumName(name))
+		}
+		return "ENUM(" + strings.Join(names, ", ") + ")"
+	case TYPE_LIST:
+		return info.childTypes[0].String() + "[]"
+	case TYPE_MAP:
+		return fmt.Sprintf("MAP(%s, %s)", info.childTypes[0].String(), info.childTypes[1].String())
+	case TYPE_ARRAY:
+		return fmt.Sprintf("%s[%d]", info.childTypes[0].String(), info.arrayLength)
+	case TYPE_STRUCT:
+		return "STRUCT(" + structEntriesString(info.structEntries) + ")"
+	case TYPE_UNION:
+		return "UNION(" + structEntriesString(info.structEntries) + ")"
+	default:
+		return typeToStringMap[info.Type]
+	}
+}
+
+func structEntriesString(entries []StructEntry) string {
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, escapeStructFieldName(entry.Name())+" "+entry.Info().String())
+	}
+	return strings.Join(names, ", ")
+}
+
+func escapeEnumName(s string) string {
+	// DuckDB escapes ENUM names like string literals by doubling single quotes, then wrapping in single quotes.
+	return `'` + strings.ReplaceAll(s, `'`, `''`) + `'`
+}
+
+func (info *typeInfo) EnumNames() ([]string, error) {
+	if info.Type != TYPE_ENUM {
+		return nil, getError(errAPI, typeInfoMismatchError(info.Type, TYPE_ENUM))
+	}
+	return slices.Clone(info.enumNames), nil
+}
+
+func (info *typeInfo) EnumDictionarySize() (int, error) {
+	if info.Type != TYPE_ENUM {
+		return 0, getError(errAPI, typeInfoMismatchError(info.Type, TYPE_ENUM))
+	}
+	return len(info.enumNames), nil
+}
+
+func (info *typeInfo) DecimalWidth() (uint8, bool) {
+	return info.decimalWidth, info.Type == TYPE_DECIMAL
+}
+
+func (info *typeInfo) DecimalScale() (uint8, bool) {
+	return info.decimalScale, info.Type == TYPE_DECIMAL
+}
+
+func (info *typeInfo) ChildType() (TypeInfo, error) {
+	if info.Type != TYPE_LIST && info.Type != TYPE_ARRAY {
+		return nil, getError(errAPI, typeInfoMismatchError(info.Type, TYPE_LIST, TYPE_ARRAY))
+	}
+	return info.childTypes[0], nil
+}
+
+func (info *typeInfo) KeyType() (TypeInfo, error) {
+	if info.Type != TYPE_MAP {
+		return nil, getError(errAPI, typeInfoMismatchError(info.Type, TYPE_MAP))
+	}
+	return info.childTypes[0], nil
+}
+
+func (info *typeInfo) ValueType() (TypeInfo, error) {
+	if info.Type != TYPE_MAP {
+		return nil, getError(errAPI, typeInfoMismatchError(info.Type, TYPE_MAP))
+	}
+	return info.childTypes[1], nil
+}
+
+func (info *typeInfo) StructFields() ([]StructField, error) {
+	if info.Type != TYPE_STRUCT {
+		return nil, getError(errAPI, typeInfoMismatchError(info.Type, TYPE_STRUCT))
+	}
+
+	fields := make([]StructField, 0, len(info.structEntries))
+	for _, entry := range info.structEntries {
+		fields = append(fields, StructField{Name: entry.Name(), Type: entry.Info()})
+	}
+	return fields, nil
+}
+
+func (info *typeInfo) details() *typeInfo {
+	return info
+}
+
 // NewTypeInfo returns type information for DuckDB's primitive types.
 // It returns the TypeInfo, if the Type parameter is a valid primitive type.
 // Else, it returns nil, and an error.
 // Valid types are:
 // TYPE_[BOOLEAN, TINYINT, SMALLINT, INTEGER, BIGINT, UTINYINT, USMALLINT, UINTEGER,
-// UBIGINT, FLOAT, DOUBLE, TIMESTAMP, DATE, TIME, INTERVAL, HUGEINT, VARCHAR, BLOB,
-// TIMESTAMP_S, TIMESTAMP_MS, TIMESTAMP_NS, UUID, TIMESTAMP_TZ, ANY].
+// UBIGINT, FLOAT, DOUBLE, TIMESTAMP, DATE, TIME, INTERVAL, HUGEINT, UHUGEINT, VARCHAR, BLOB,
+// TIMESTAMP_S, TIMESTAMP_MS, TIMESTAMP_NS, UUID, BIT, TIMESTAMP_TZ, ANY].
 func NewTypeInfo(t Type) (TypeInfo, error) {
 	name, inMap := unsupportedTypeToStringMap[t]
 	if inMap && t != TYPE_ANY {
@@ -105,7 +277,10 @@ func NewTypeInfo(t Type) (TypeInfo, error) {
 		return nil, getError(errAPI, tryOtherFuncError(funcName(NewMapInfo)))
 	case TYPE_ARRAY:
 		return nil, getError(errAPI, tryOtherFuncError(funcName(NewArrayInfo)))
-	case TYPE_SQLNULL:
+	case TYPE_UNION:
+		return nil, getError(errAPI, tryOtherFuncError(funcName(NewUnionInfo)))
+	case TYPE_SQLNULL, TYPE_VARINT:
+		// DuckDB's C API cannot create VARINT logical types, e.g., for the arguments of UDFs.
 		return nil, getError(errAPI, unsupportedTypeError(typeToStringMap[t]))
 	}
 
@@ -116,12 +291,13 @@ func NewTypeInfo(t Type) (TypeInfo, error) {
 
 // NewDecimalInfo returns DECIMAL type information.
 // Its input parameters are the width and scale of the DECIMAL type.
+// The width must be between 1 and 38, and the scale must be between 0 and the width.
 func NewDecimalInfo(width uint8, scale uint8) (TypeInfo, error) {
 	if width < 1 || width > max_decimal_width {
-		return nil, getError(errAPI, errInvalidDecimalWidth)
+		return nil, getError(errAPI, invalidDecimalError(errInvalidDecimalWidth, width, scale))
 	}
 	if scale > width {
-		return nil, getError(errAPI, errInvalidDecimalScale)
+		return nil, getError(errAPI, invalidDecimalError(errInvalidDecimalScale, width, scale))
 	}
 
 	return &typeInfo{
@@ -133,9 +309,52 @@ func NewDecimalInfo(width uint8, scale uint8) (TypeInfo, error) {
 	}, nil
 }
 
+// TimeUnit is the precision of a TIMESTAMP type.
+type TimeUnit uint8
+
+const (
+	// TimeUnitSecond is the precision of TIMESTAMP_S.
+	TimeUnitSecond TimeUnit = iota + 1
+	// TimeUnitMillisecond is the precision of TIMESTAMP_MS.
+	TimeUnitMillisecond
+	// TimeUnitMicrosecond is the precision of TIMESTAMP.
+	TimeUnitMicrosecond
+	// TimeUnitNanosecond is the precision of TIMESTAMP_NS.
+	TimeUnitNanosecond
+)
+
+// timestampTypes maps each TimeUnit to its TIMESTAMP type.
+var timestampTypes = map[TimeUnit]Type{
+	TimeUnitSecond:      TYPE_TIMESTAMP_S,
+	TimeUnitMillisecond: TYPE_TIMESTAMP_MS,
+	TimeUnitMicrosecond: TYPE_TIMESTAMP,
+	TimeUnitNanosecond:  TYPE_TIMESTAMP_NS,
+}
+
+// NewTimestampInfo returns the type information of the TIMESTAMP type with the precision unit,
+// e.g., TIMESTAMP_NS for TimeUnitNanosecond.
+func NewTimestampInfo(unit TimeUnit) (TypeInfo, error) {
+	t, ok := timestampTypes[unit]
+	if !ok {
+		return nil, getError(errAPI, invalidInputError(strconv.Itoa(int(unit)), "a TimeUnit"))
+	}
+	return NewTypeInfo(t)
+}
+
 // NewEnumInfo returns ENUM type information.
-// Its input parameters are the dictionary values.
+// Its input parameters are the dictionary values, and an ENUM has at least one value.
+// The values must be unique, and they must not contain NUL bytes.
 func NewEnumInfo(first string, others ...string) (TypeInfo, error) {
+	// The C API expects NUL-terminated names.
+	if strings.ContainsRune(first, 0) {
+		return nil, getError(errAPI, addIndexToError(errNULByteInName, 0))
+	}
+	for i, name := range others {
+		if strings.ContainsRune(name, 0) {
+			return nil, getError(errAPI, addIndexToError(errNULByteInName, i+1))
+		}
+	}
+
 	// Check for duplicate names.
 	m := map[string]bool{}
 	m[first] = true
@@ -218,6 +437,8 @@ func NewStructInfo(firstEntry StructEntry, others ...StructEntry) (TypeInfo, err
 // NewMapInfo returns MAP type information.
 // keyInfo contains the type information of the MAP keys.
 // valueInfo contains the type information of the MAP values.
+// Go maps require comparable keys, so LIST, STRUCT, MAP, ARRAY, and UNION keys are not supported.
+// BLOB keys convert to string keys, and UUID keys to UUID keys.
 func NewMapInfo(keyInfo TypeInfo, valueInfo TypeInfo) (TypeInfo, error) {
 	if keyInfo == nil {
 		return nil, getError(errAPI, interfaceIsNilError("keyInfo"))
@@ -225,6 +446,9 @@ func NewMapInfo(keyInfo TypeInfo, valueInfo TypeInfo) (TypeInfo, error) {
 	if valueInfo == nil {
 		return nil, getError(errAPI, interfaceIsNilError("valueInfo"))
 	}
+	if t := keyInfo.InternalType(); !isComparableMapKeyType(t) {
+		return nil, getError(errAPI, fmt.Errorf("%w: %s", errUnsupportedMapKeyType, typeToStringMap[t]))
+	}
 
 	info := &typeInfo{
 		baseTypeInfo: baseTypeInfo{Type: TYPE_MAP},
@@ -235,14 +459,27 @@ func NewMapInfo(keyInfo TypeInfo, valueInfo TypeInfo) (TypeInfo, error) {
 	return info, nil
 }
 
+// isComparableMapKeyType returns true, if the MAP keys of type t convert to comparable Go values.
+// UNION values can hold non-comparable members.
+func isComparableMapKeyType(t Type) bool {
+	switch t {
+	case TYPE_LIST, TYPE_STRUCT, TYPE_MAP, TYPE_ARRAY, TYPE_UNION:
+		return false
+	}
+	return true
+}
+
+// max_array_size is DuckDB's maximum ARRAY size.
+const max_array_size = 100000
+
 // NewArrayInfo returns ARRAY type information.
 // childInfo contains the type information of the ARRAY's elements.
-// size is the ARRAY's fixed size.
+// size is the ARRAY's fixed size, which must be between 1 and 100000.
 func NewArrayInfo(childInfo TypeInfo, size uint64) (TypeInfo, error) {
 	if childInfo == nil {
 		return nil, getError(errAPI, interfaceIsNilError("childInfo"))
 	}
-	if size == 0 {
+	if size == 0 || size > max_array_size {
 		return nil, getError(errAPI, errInvalidArraySize)
 	}
 
@@ -254,12 +491,51 @@ func NewArrayInfo(childInfo TypeInfo, size uint64) (TypeInfo, error) {
 	return info, nil
 }
 
+// NewUnionInfo returns UNION type information.
+// memberTypes contains the type information of the UNION members, and memberNames holds their names (tags).
+// Both slices must have the same length, and each name must be non-empty and unique.
+func NewUnionInfo(memberTypes []TypeInfo, memberNames []string) (TypeInfo, error) {
+	if len(memberTypes) == 0 {
+		return nil, getError(errAPI, errEmptyUnion)
+	}
+	if len(memberTypes) != len(memberNames) {
+		return nil, getError(errAPI, errUnionMemberCount)
+	}
+
+	info := &typeInfo{
+		baseTypeInfo: baseTypeInfo{
+			Type:          TYPE_UNION,
+			structEntries: make([]StructEntry, 0, len(memberTypes)),
+		},
+	}
+
+	// Check for nil types, empty names, and duplicate names.
+	m := map[string]bool{}
+	for i, memberType := range memberTypes {
+		if memberType == nil {
+			return nil, getError(errAPI, addIndexToError(interfaceIsNilError("memberType"), i))
+		}
+		name := memberNames[i]
+		if _, inMap := m[name]; inMap {
+			return nil, getError(errAPI, duplicateNameError(name))
+		}
+		m[name] = true
+
+		entry, err := NewStructEntry(memberType, name)
+		if err != nil {
+			return nil, err
+		}
+		info.structEntries = append(info.structEntries, entry)
+	}
+	return info, nil
+}
+
 func (info *typeInfo) logicalType() C.duckdb_logical_type {
 	switch info.Type {
 	case TYPE_BOOLEAN, TYPE_TINYINT, TYPE_SMALLINT, TYPE_INTEGER, TYPE_BIGINT, TYPE_UTINYINT, TYPE_USMALLINT,
 		TYPE_UINTEGER, TYPE_UBIGINT, TYPE_FLOAT, TYPE_DOUBLE, TYPE_TIMESTAMP, TYPE_TIMESTAMP_S, TYPE_TIMESTAMP_MS,
-		TYPE_TIMESTAMP_NS, TYPE_TIMESTAMP_TZ, TYPE_DATE, TYPE_TIME, TYPE_TIME_TZ, TYPE_INTERVAL, TYPE_HUGEINT, TYPE_VARCHAR,
-		TYPE_BLOB, TYPE_UUID, TYPE_ANY:
+		TYPE_TIMESTAMP_NS, TYPE_TIMESTAMP_TZ, TYPE_DATE, TYPE_TIME, TYPE_TIME_TZ, TYPE_INTERVAL, TYPE_HUGEINT, TYPE_UHUGEINT,
+		TYPE_VARCHAR, TYPE_BLOB, TYPE_UUID, TYPE_BIT, TYPE_ANY:
 		return C.duckdb_create_logical_type(C.duckdb_type(info.Type))
 
 	case TYPE_DECIMAL:
@@ -274,6 +550,8 @@ func (info *typeInfo) logicalType() C.duckdb_logical_type {
 		return info.logicalMapType()
 	case TYPE_ARRAY:
 		return info.logicalArrayType()
+	case TYPE_UNION:
+		return info.logicalUnionType()
 	}
 	return nil
 }
@@ -346,6 +624,93 @@ func (info *typeInfo) logicalArrayType() C.duckdb_logical_type {
 	return logicalType
 }
 
+func (info *typeInfo) logicalUnionType() C.duckdb_logical_type {
+	count := len(info.structEntries)
+	size := C.size_t(unsafe.Sizeof(C.duckdb_logical_type(nil)))
+	types := (*[1 << 31]C.duckdb_logical_type)(C.malloc(C.size_t(count) * size))
+
+	size = C.size_t(unsafe.Sizeof((*C.char)(nil)))
+	names := (*[1 << 31]*C.char)(C.malloc(C.size_t(count) * size))
+
+	for i, entry := range info.structEntries {
+		(*types)[i] = entry.Info().logicalType()
+		(*names)[i] = C.CString(entry.Name())
+	}
+
+	cTypes := (*C.duckdb_logical_type)(unsafe.Pointer(types))
+	cNames := (**C.char)(unsafe.Pointer(names))
+	logicalType := C.duckdb_create_union_type(cTypes, cNames, C.idx_t(count))
+
+	for i := 0; i < count; i++ {
+		C.duckdb_destroy_logical_type(&types[i])
+		C.duckdb_free(unsafe.Pointer((*names)[i]))
+	}
+	C.duckdb_free(unsafe.Pointer(types))
+	C.duckdb_free(unsafe.Pointer(names))
+	return logicalType
+}
+
+// newTypeInfoFromLogicalType returns the type information of a logical type.
+// Unlike NewTypeInfo, it also returns type information for types that the package cannot create.
+func newTypeInfoFromLogicalType(logicalType C.duckdb_logical_type) TypeInfo {
+	t := Type(C.duckdb_get_type_id(logicalType))
+	info := &typeInfo{baseTypeInfo: baseTypeInfo{Type: t}}
+
+	switch t {
+	case TYPE_DECIMAL:
+		info.decimalWidth = uint8(C.duckdb_decimal_width(logicalType))
+		info.decimalScale = uint8(C.duckdb_decimal_scale(logicalType))
+	case TYPE_ENUM:
+		size := int(C.duckdb_enum_dictionary_size(logicalType))
+		info.enumNames = make([]string, 0, size)
+		for i := 0; i < size; i++ {
+			name := C.duckdb_enum_dictionary_value(logicalType, C.idx_t(i))
+			info.enumNames = append(info.enumNames, C.GoString(name))
+			C.duckdb_free(unsafe.Pointer(name))
+		}
+	case TYPE_LIST:
+		child := C.duckdb_list_type_child_type(logicalType)
+		info.childTypes = []TypeInfo{newTypeInfoFromLogicalType(child)}
+		C.duckdb_destroy_logical_type(&child)
+	case TYPE_ARRAY:
+		child := C.duckdb_array_type_child_type(logicalType)
+		info.childTypes = []TypeInfo{newTypeInfoFromLogicalType(child)}
+		C.duckdb_destroy_logical_type(&child)
+		info.arrayLength = uint64(C.duckdb_array_type_array_size(logicalType))
+	case TYPE_MAP:
+		key := C.duckdb_map_type_key_type(logicalType)
+		value := C.duckdb_map_type_value_type(logicalType)
+		info.childTypes = []TypeInfo{newTypeInfoFromLogicalType(key), newTypeInfoFromLogicalType(value)}
+		C.duckdb_destroy_logical_type(&key)
+		C.duckdb_destroy_logical_type(&value)
+	case TYPE_STRUCT:
+		count := int(C.duckdb_struct_type_child_count(logicalType))
+		for i := 0; i < count; i++ {
+			name := C.duckdb_struct_type_child_name(logicalType, C.idx_t(i))
+			child := C.duckdb_struct_type_child_type(logicalType, C.idx_t(i))
+			info.structEntries = append(info.structEntries, &structEntry{
+				TypeInfo: newTypeInfoFromLogicalType(child),
+				name:     C.GoString(name),
+			})
+			C.duckdb_destroy_logical_type(&child)
+			C.duckdb_free(unsafe.Pointer(name))
+		}
+	case TYPE_UNION:
+		count := int(C.duckdb_union_type_member_count(logicalType))
+		for i := 0; i < count; i++ {
+			name := C.duckdb_union_type_member_name(logicalType, C.idx_t(i))
+			member := C.duckdb_union_type_member_type(logicalType, C.idx_t(i))
+			info.structEntries = append(info.structEntries, &structEntry{
+				TypeInfo: newTypeInfoFromLogicalType(member),
+				name:     C.GoString(name),
+			})
+			C.duckdb_destroy_logical_type(&member)
+			C.duckdb_free(unsafe.Pointer(name))
+		}
+	}
+	return info
+}
+
 func funcName(i interface{}) string {
 	return runtime.FuncForPC(reflect.ValueOf(i).Pointer()).Name()
 }
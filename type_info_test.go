@@ -1,7 +1,12 @@
 package duckdb
 
 import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -33,13 +38,15 @@ var testPrimitiveSQLValues = map[Type]testTypeValues{
 	TYPE_TIME:         {input: `TIME '1992-09-20 11:30:00.123456'`, output: `11:30:00.123456`},
 	TYPE_INTERVAL:     {input: `INTERVAL 1 YEAR`, output: `1 year`},
 	TYPE_HUGEINT:      {input: `44::HUGEINT`, output: `44`},
+	TYPE_UHUGEINT:     {input: `44::UHUGEINT`, output: `44`},
 	TYPE_VARCHAR:      {input: `'hello world'::VARCHAR`, output: `hello world`},
 	TYPE_BLOB:         {input: `'\xAA'::BLOB`, output: `\xAA`},
 	TYPE_TIMESTAMP_S:  {input: `TIMESTAMP_S '1992-09-20 11:30:00'`, output: `1992-09-20 11:30:00`},
 	TYPE_TIMESTAMP_MS: {input: `TIMESTAMP_MS '1992-09-20 11:30:00.123'`, output: `1992-09-20 11:30:00.123`},
 	TYPE_TIMESTAMP_NS: {input: `TIMESTAMP_NS '1992-09-20 11:30:00.123456789'`, output: `1992-09-20 11:30:00.123456789`},
 	TYPE_UUID:         {input: `uuid()`, output: ``},
-	TYPE_TIME_TZ:      {input: `TIMETZ '1992-09-20 11:30:00.123456+06'`, output: `05:30:00.123456+00`},
+	TYPE_BIT:          {input: `'0101'::BIT`, output: `0101`},
+	TYPE_TIME_TZ:      {input: `TIMETZ '11:30:00.123456+06'`, output: `11:30:00.123456+06`},
 	TYPE_TIMESTAMP_TZ: {input: `TIMESTAMPTZ '1992-09-20 11:30:00.123456'`, output: `1992-09-20 11:30:00.123456+00`},
 }
 
@@ -54,7 +61,7 @@ func getTypeInfos(t *testing.T, useAny bool) []testTypeInfo {
 			continue
 		}
 		switch k {
-		case TYPE_DECIMAL, TYPE_ENUM, TYPE_LIST, TYPE_STRUCT, TYPE_MAP, TYPE_ARRAY, TYPE_SQLNULL:
+		case TYPE_DECIMAL, TYPE_ENUM, TYPE_LIST, TYPE_STRUCT, TYPE_MAP, TYPE_ARRAY, TYPE_UNION, TYPE_SQLNULL, TYPE_VARINT:
 			continue
 		}
 		primitiveTypes = append(primitiveTypes, k)
@@ -183,9 +190,22 @@ func getTypeInfos(t *testing.T, useAny bool) []testTypeInfo {
 		},
 	}
 
+	varcharInfo, err := NewTypeInfo(TYPE_VARCHAR)
+	require.NoError(t, err)
+
+	info, err = NewUnionInfo([]TypeInfo{primitiveInfo, varcharInfo, listTypeInfo}, []string{"num", "str", "list"})
+	require.NoError(t, err)
+	unionTypeInfo := testTypeInfo{
+		TypeInfo: info,
+		testTypeValues: testTypeValues{
+			input:  `'hello'::UNION(num INTEGER, str VARCHAR, list DECIMAL(3, 2)[])`,
+			output: `hello`,
+		},
+	}
+
 	testTypeInfos = append(testTypeInfos, decimalTypeInfo, enumTypeInfo,
 		listTypeInfo, nestedListTypeInfo, structTypeInfo, nestedStructTypeInfo, mapTypeInfo,
-		arrayTypeInfo, nestedArrayTypeInfo)
+		arrayTypeInfo, nestedArrayTypeInfo, unionTypeInfo)
 	return testTypeInfos
 }
 
@@ -199,11 +219,365 @@ func TestTypeInterface(t *testing.T) {
 	}
 }
 
+func TestTypeInfoEquals(t *testing.T) {
+	testTypeInfos := getTypeInfos(t, false)
+
+	// Each type is equal to itself, and different from all other types.
+	for i, info := range testTypeInfos {
+		for j, other := range testTypeInfos {
+			require.Equal(t, i == j, info.Equals(other.TypeInfo), "%s vs. %s", info.output, other.output)
+		}
+		require.False(t, info.Equals(nil))
+	}
+
+	newInfo := func(info TypeInfo, err error) TypeInfo {
+		require.NoError(t, err)
+		return info
+	}
+	intInfo := newInfo(NewTypeInfo(TYPE_INTEGER))
+	varcharInfo := newInfo(NewTypeInfo(TYPE_VARCHAR))
+	newEntry := func(info TypeInfo, name string) StructEntry {
+		entry, err := NewStructEntry(info, name)
+		require.NoError(t, err)
+		return entry
+	}
+
+	// Decimals.
+	require.True(t, newInfo(NewDecimalInfo(5, 2)).Equals(newInfo(NewDecimalInfo(5, 2))))
+	require.False(t, newInfo(NewDecimalInfo(5, 2)).Equals(newInfo(NewDecimalInfo(5, 3))))
+	require.False(t, newInfo(NewDecimalInfo(5, 2)).Equals(newInfo(NewDecimalInfo(6, 2))))
+
+	// Enums compare their members in order.
+	require.True(t, newInfo(NewEnumInfo("a", "b")).Equals(newInfo(NewEnumInfo("a", "b"))))
+	require.False(t, newInfo(NewEnumInfo("a", "b")).Equals(newInfo(NewEnumInfo("b", "a"))))
+	require.False(t, newInfo(NewEnumInfo("a", "b")).Equals(newInfo(NewEnumInfo("a", "b", "c"))))
+
+	// Lists and arrays.
+	require.True(t, newInfo(NewListInfo(intInfo)).Equals(newInfo(NewListInfo(intInfo))))
+	require.False(t, newInfo(NewListInfo(intInfo)).Equals(newInfo(NewListInfo(varcharInfo))))
+	require.True(t, newInfo(NewArrayInfo(intInfo, 3)).Equals(newInfo(NewArrayInfo(intInfo, 3))))
+	require.False(t, newInfo(NewArrayInfo(intInfo, 3)).Equals(newInfo(NewArrayInfo(intInfo, 4))))
+	require.False(t, newInfo(NewArrayInfo(intInfo, 3)).Equals(newInfo(NewListInfo(intInfo))))
+
+	// Maps.
+	require.True(t, newInfo(NewMapInfo(intInfo, varcharInfo)).Equals(newInfo(NewMapInfo(intInfo, varcharInfo))))
+	require.False(t, newInfo(NewMapInfo(intInfo, varcharInfo)).Equals(newInfo(NewMapInfo(varcharInfo, intInfo))))
+
+	// Structs compare their field names and types in order.
+	structInfo := newInfo(NewStructInfo(newEntry(intInfo, "a"), newEntry(varcharInfo, "b")))
+	require.True(t, structInfo.Equals(newInfo(NewStructInfo(newEntry(intInfo, "a"), newEntry(varcharInfo, "b")))))
+	require.False(t, structInfo.Equals(newInfo(NewStructInfo(newEntry(intInfo, "a"), newEntry(varcharInfo, "c")))))
+	require.False(t, structInfo.Equals(newInfo(NewStructInfo(newEntry(varcharInfo, "b"), newEntry(intInfo, "a")))))
+	require.False(t, structInfo.Equals(newInfo(NewStructInfo(newEntry(intInfo, "a")))))
+
+	// Nested types compare recursively.
+	nestedInfo := newInfo(NewListInfo(newInfo(NewMapInfo(varcharInfo, structInfo))))
+	require.True(t, nestedInfo.Equals(newInfo(NewListInfo(newInfo(NewMapInfo(varcharInfo, structInfo))))))
+	otherStructInfo := newInfo(NewStructInfo(newEntry(intInfo, "a"), newEntry(intInfo, "b")))
+	require.False(t, nestedInfo.Equals(newInfo(NewListInfo(newInfo(NewMapInfo(varcharInfo, otherStructInfo))))))
+
+	// Unions.
+	unionInfo := newInfo(NewUnionInfo([]TypeInfo{intInfo, varcharInfo}, []string{"num", "str"}))
+	require.True(t, unionInfo.Equals(newInfo(NewUnionInfo([]TypeInfo{intInfo, varcharInfo}, []string{"num", "str"}))))
+	require.False(t, unionInfo.Equals(newInfo(NewUnionInfo([]TypeInfo{intInfo, varcharInfo}, []string{"num", "text"}))))
+	require.False(t, unionInfo.Equals(structInfo))
+}
+
+func TestTypeInfoString(t *testing.T) {
+	db := openDB(t)
+	_, err := db.Exec(`CREATE TYPE greeting AS ENUM ('hello', 'world', '!')`)
+	require.NoError(t, err)
+
+	// Each type name is valid SQL.
+	for _, info := range getTypeInfos(t, false) {
+		_, err = db.Exec(`CREATE OR REPLACE TABLE test (col ` + info.String() + `)`)
+		require.NoError(t, err, info.String())
+		_, err = db.Exec(`INSERT INTO test VALUES (` + info.input + `)`)
+		require.NoError(t, err, info.String())
+
+		var res string
+		require.NoError(t, db.QueryRow(`SELECT col::VARCHAR FROM test`).Scan(&res))
+		if info.InternalType() != TYPE_UUID {
+			require.Equal(t, info.output, res)
+		}
+	}
+
+	enumInfo, err := NewEnumInfo("it's", "a", `"b"`)
+	require.NoError(t, err)
+	require.Equal(t, `ENUM('it''s', 'a', '"b"')`, enumInfo.String())
+
+	decimalInfo, err := NewDecimalInfo(3, 2)
+	require.NoError(t, err)
+	listInfo, err := NewListInfo(decimalInfo)
+	require.NoError(t, err)
+	nestedListInfo, err := NewListInfo(listInfo)
+	require.NoError(t, err)
+	require.Equal(t, "DECIMAL(3,2)[][]", nestedListInfo.String())
+
+	enumEntry, err := NewStructEntry(enumInfo, "hello")
+	require.NoError(t, err)
+	listEntry, err := NewStructEntry(nestedListInfo, `wor"ld`)
+	require.NoError(t, err)
+	structInfo, err := NewStructInfo(enumEntry, listEntry)
+	require.NoError(t, err)
+	require.Equal(t, `STRUCT("hello" ENUM('it''s', 'a', '"b"'), "wor""ld" DECIMAL(3,2)[][])`, structInfo.String())
+
+	arrayInfo, err := NewArrayInfo(structInfo, 2)
+	require.NoError(t, err)
+	mapInfo, err := NewMapInfo(decimalInfo, arrayInfo)
+	require.NoError(t, err)
+	require.Equal(t, `MAP(DECIMAL(3,2), STRUCT("hello" ENUM('it''s', 'a', '"b"'), "wor""ld" DECIMAL(3,2)[][])[2])`, mapInfo.String())
+
+	_, err = db.Exec(`CREATE OR REPLACE TABLE test (col ` + mapInfo.String() + `)`)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+}
+
+func TestColumnTypeInfo(t *testing.T) {
+	db := openDB(t)
+	_, err := db.Exec(`CREATE TYPE greeting AS ENUM ('hello', 'world', '!')`)
+	require.NoError(t, err)
+
+	conn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+
+	// The result columns have the type information of the table columns.
+	for _, info := range getTypeInfos(t, false) {
+		_, err = conn.ExecContext(context.Background(), `CREATE OR REPLACE TABLE test (col `+info.String()+`)`)
+		require.NoError(t, err, info.String())
+
+		err = conn.Raw(func(driverConn any) error {
+			r, err := driverConn.(*Conn).QueryContext(context.Background(), `SELECT col FROM test`, nil)
+			require.NoError(t, err)
+
+			columnInfo, err := ColumnTypeInfo(r, 0)
+			require.NoError(t, err)
+			require.True(t, info.Equals(columnInfo), info.String())
+			require.Equal(t, info.String(), columnInfo.String())
+			require.Equal(t, info.InternalType(), columnInfo.InternalType())
+
+			_, err = ColumnTypeInfo(r, 1)
+			testError(t, err, errAPI.Error(), columnCountErrMsg)
+			return r.Close()
+		})
+		require.NoError(t, err)
+	}
+
+	_, err = ColumnTypeInfo(nil, 0)
+	testError(t, err, errAPI.Error(), errInvalidRows.Error())
+
+	require.NoError(t, conn.Close())
+	require.NoError(t, db.Close())
+}
+
+func TestTypeInfoInternalType(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	conn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+
+	// Nested types report their outer type.
+	err = conn.Raw(func(driverConn any) error {
+		r, err := driverConn.(*Conn).QueryContext(context.Background(), `SELECT [1], [1, 2]::INTEGER[2], {'a': 1},
+			MAP {'k': [1.5]}, 42::UNION(i INTEGER, s VARCHAR), 1.5::DECIMAL(3, 1), 'a'::ENUM('a', 'b')`, nil)
+		require.NoError(t, err)
+
+		expected := []Type{TYPE_LIST, TYPE_ARRAY, TYPE_STRUCT, TYPE_MAP, TYPE_UNION, TYPE_DECIMAL, TYPE_ENUM}
+		for i, typ := range expected {
+			info, err := ColumnTypeInfo(r, i)
+			require.NoError(t, err)
+			require.Equal(t, typ, info.InternalType(), info.String())
+		}
+
+		info, err := ColumnTypeInfo(r, 3)
+		require.NoError(t, err)
+		valueInfo, err := info.ValueType()
+		require.NoError(t, err)
+		require.Equal(t, TYPE_LIST, valueInfo.InternalType())
+		return r.Close()
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, conn.Close())
+	require.NoError(t, db.Close())
+}
+
+func TestTypeInfoEnumNames(t *testing.T) {
+	info, err := NewEnumInfo("hello", "world", "!")
+	require.NoError(t, err)
+
+	names, err := info.EnumNames()
+	require.NoError(t, err)
+	require.Equal(t, []string{"hello", "world", "!"}, names)
+	size, err := info.EnumDictionarySize()
+	require.NoError(t, err)
+	require.Equal(t, 3, size)
+
+	// Modifying the names does not modify the type information.
+	names[0] = "bye"
+	names, err = info.EnumNames()
+	require.NoError(t, err)
+	require.Equal(t, "hello", names[0])
+
+	intInfo, err := NewTypeInfo(TYPE_INTEGER)
+	require.NoError(t, err)
+	_, err = intInfo.EnumNames()
+	testError(t, err, errAPI.Error(), typeInfoMismatchErrMsg, "expected ENUM, got INTEGER")
+	_, err = intInfo.EnumDictionarySize()
+	testError(t, err, errAPI.Error(), typeInfoMismatchErrMsg)
+}
+
+func TestTypeInfoDecimal(t *testing.T) {
+	info, err := NewDecimalInfo(3, 2)
+	require.NoError(t, err)
+
+	width, ok := info.DecimalWidth()
+	require.True(t, ok)
+	require.Equal(t, uint8(3), width)
+	scale, ok := info.DecimalScale()
+	require.True(t, ok)
+	require.Equal(t, uint8(2), scale)
+
+	// Recreating the type from its width and scale yields an equal type.
+	other, err := NewDecimalInfo(width, scale)
+	require.NoError(t, err)
+	require.True(t, info.Equals(other))
+
+	for _, info := range getTypeInfos(t, false) {
+		if info.InternalType() == TYPE_DECIMAL {
+			continue
+		}
+		width, ok = info.DecimalWidth()
+		require.False(t, ok)
+		require.Zero(t, width)
+		scale, ok = info.DecimalScale()
+		require.False(t, ok)
+		require.Zero(t, scale)
+	}
+}
+
+func TestTypeInfoTimestamp(t *testing.T) {
+	c, err := NewConnector("", nil)
+	require.NoError(t, err)
+	db := sql.OpenDB(c)
+
+	ts := time.Date(1992, 9, 20, 11, 30, 0, 123456789, time.UTC)
+	tests := []struct {
+		unit     TimeUnit
+		t        Type
+		expected time.Time
+	}{
+		{unit: TimeUnitSecond, t: TYPE_TIMESTAMP_S, expected: ts.Truncate(time.Second)},
+		{unit: TimeUnitMillisecond, t: TYPE_TIMESTAMP_MS, expected: ts.Truncate(time.Millisecond)},
+		{unit: TimeUnitMicrosecond, t: TYPE_TIMESTAMP, expected: ts.Truncate(time.Microsecond)},
+		{unit: TimeUnitNanosecond, t: TYPE_TIMESTAMP_NS, expected: ts},
+	}
+
+	var columns []string
+	for i, test := range tests {
+		info, err := NewTimestampInfo(test.unit)
+		require.NoError(t, err)
+		require.Equal(t, test.t, info.InternalType())
+		columns = append(columns, fmt.Sprintf("c%d %s", i, info))
+	}
+	_, err = db.Exec(`CREATE TABLE test (` + strings.Join(columns, ", ") + `)`)
+	require.NoError(t, err)
+
+	// Appending and scanning preserve the precision of each type.
+	con, err := c.Connect(context.Background())
+	require.NoError(t, err)
+	a, err := NewAppenderFromConn(con, "", "test")
+	require.NoError(t, err)
+	require.NoError(t, a.AppendRow(ts, ts, ts, ts))
+	require.NoError(t, a.Close())
+	require.NoError(t, con.Close())
+
+	got := make([]time.Time, len(tests))
+	require.NoError(t, db.QueryRow(`SELECT * FROM test`).Scan(&got[0], &got[1], &got[2], &got[3]))
+	for i, test := range tests {
+		require.Equal(t, test.expected, got[i], test.t)
+	}
+
+	require.NoError(t, db.Close())
+	require.NoError(t, c.Close())
+}
+
+func TestTypeInfoChildTypes(t *testing.T) {
+	decimalInfo, err := NewDecimalInfo(3, 2)
+	require.NoError(t, err)
+	listInfo, err := NewListInfo(decimalInfo)
+	require.NoError(t, err)
+	nestedListInfo, err := NewListInfo(listInfo)
+	require.NoError(t, err)
+
+	// Recurse into a nested LIST.
+	child, err := nestedListInfo.ChildType()
+	require.NoError(t, err)
+	require.True(t, child.Equals(listInfo))
+	child, err = child.ChildType()
+	require.NoError(t, err)
+	require.True(t, child.Equals(decimalInfo))
+	_, err = child.ChildType()
+	testError(t, err, errAPI.Error(), typeInfoMismatchErrMsg, "expected LIST or ARRAY, got DECIMAL")
+
+	arrayInfo, err := NewArrayInfo(listInfo, 2)
+	require.NoError(t, err)
+	child, err = arrayInfo.ChildType()
+	require.NoError(t, err)
+	require.True(t, child.Equals(listInfo))
+
+	mapInfo, err := NewMapInfo(decimalInfo, arrayInfo)
+	require.NoError(t, err)
+	key, err := mapInfo.KeyType()
+	require.NoError(t, err)
+	require.True(t, key.Equals(decimalInfo))
+	value, err := mapInfo.ValueType()
+	require.NoError(t, err)
+	require.True(t, value.Equals(arrayInfo))
+
+	_, err = mapInfo.ChildType()
+	testError(t, err, errAPI.Error(), typeInfoMismatchErrMsg)
+	_, err = listInfo.KeyType()
+	testError(t, err, errAPI.Error(), typeInfoMismatchErrMsg, "expected MAP, got LIST")
+	_, err = listInfo.ValueType()
+	testError(t, err, errAPI.Error(), typeInfoMismatchErrMsg)
+}
+
+func TestTypeInfoStructFields(t *testing.T) {
+	for _, info := range getTypeInfos(t, false) {
+		if info.InternalType() == TYPE_STRUCT {
+			continue
+		}
+		_, err := info.StructFields()
+		testError(t, err, errAPI.Error(), typeInfoMismatchErrMsg)
+	}
+
+	intInfo, err := NewTypeInfo(TYPE_INTEGER)
+	require.NoError(t, err)
+	varcharInfo, err := NewTypeInfo(TYPE_VARCHAR)
+	require.NoError(t, err)
+	first, err := NewStructEntry(varcharInfo, "z")
+	require.NoError(t, err)
+	second, err := NewStructEntry(intInfo, "a")
+	require.NoError(t, err)
+	structInfo, err := NewStructInfo(first, second)
+	require.NoError(t, err)
+
+	// The fields keep their declaration order.
+	fields, err := structInfo.StructFields()
+	require.NoError(t, err)
+	require.Len(t, fields, 2)
+	require.Equal(t, "z", fields[0].Name)
+	require.True(t, fields[0].Type.Equals(varcharInfo))
+	require.Equal(t, "a", fields[1].Name)
+	require.True(t, fields[1].Type.Equals(intInfo))
+}
+
 func TestErrTypeInfo(t *testing.T) {
 	t.Parallel()
 
 	var incorrectTypes []Type
-	incorrectTypes = append(incorrectTypes, TYPE_DECIMAL, TYPE_ENUM, TYPE_LIST, TYPE_STRUCT, TYPE_MAP, TYPE_ARRAY)
+	incorrectTypes = append(incorrectTypes, TYPE_DECIMAL, TYPE_ENUM, TYPE_LIST, TYPE_STRUCT, TYPE_MAP, TYPE_ARRAY, TYPE_UNION)
 
 	for _, incorrect := range incorrectTypes {
 		_, err := NewTypeInfo(incorrect)
@@ -216,7 +590,7 @@ func TestErrTypeInfo(t *testing.T) {
 			unsupportedTypes = append(unsupportedTypes, k)
 		}
 	}
-	unsupportedTypes = append(unsupportedTypes, TYPE_SQLNULL)
+	unsupportedTypes = append(unsupportedTypes, TYPE_SQLNULL, TYPE_VARINT)
 
 	for _, unsupported := range unsupportedTypes {
 		_, err := NewTypeInfo(unsupported)
@@ -227,15 +601,25 @@ func TestErrTypeInfo(t *testing.T) {
 	_, err := NewDecimalInfo(0, 0)
 	testError(t, err, errAPI.Error(), errInvalidDecimalWidth.Error())
 	_, err = NewDecimalInfo(42, 20)
-	testError(t, err, errAPI.Error(), errInvalidDecimalWidth.Error())
+	testError(t, err, errAPI.Error(), errInvalidDecimalWidth.Error(), "DECIMAL(42, 20)")
 	_, err = NewDecimalInfo(5, 6)
-	testError(t, err, errAPI.Error(), errInvalidDecimalScale.Error())
+	testError(t, err, errAPI.Error(), errInvalidDecimalScale.Error(), "DECIMAL(5, 6)")
+	_, err = NewDecimalInfo(3, 5)
+	testError(t, err, errAPI.Error(), errInvalidDecimalScale.Error(), "DECIMAL(3, 5)")
 
 	// Invalid ENUM.
 	_, err = NewEnumInfo("hello", "hello")
 	testError(t, err, errAPI.Error(), duplicateNameErrMsg)
 	_, err = NewEnumInfo("hello", "world", "hello")
 	testError(t, err, errAPI.Error(), duplicateNameErrMsg)
+	_, err = NewEnumInfo("hel\x00lo", "world")
+	testError(t, err, errAPI.Error(), errNULByteInName.Error(), indexErrMsg+": 0")
+	_, err = NewEnumInfo("hello", "world", "\x00")
+	testError(t, err, errAPI.Error(), errNULByteInName.Error(), indexErrMsg+": 2")
+
+	// Invalid TIMESTAMP precision.
+	_, err = NewTimestampInfo(0)
+	testError(t, err, errAPI.Error(), invalidInputErrMsg, "a TimeUnit")
 
 	validInfo, err := NewTypeInfo(TYPE_FLOAT)
 	require.NoError(t, err)
@@ -254,6 +638,20 @@ func TestErrTypeInfo(t *testing.T) {
 	// Invalid ARRAY entry.
 	_, err = NewArrayInfo(validInfo, 0)
 	testError(t, err, errAPI.Error(), errInvalidArraySize.Error())
+	_, err = NewArrayInfo(validInfo, max_array_size+1)
+	testError(t, err, errAPI.Error(), errInvalidArraySize.Error())
+
+	// Invalid UNION.
+	_, err = NewUnionInfo(nil, nil)
+	testError(t, err, errAPI.Error(), errEmptyUnion.Error())
+	_, err = NewUnionInfo([]TypeInfo{validInfo}, []string{"hello", "world"})
+	testError(t, err, errAPI.Error(), errUnionMemberCount.Error())
+	_, err = NewUnionInfo([]TypeInfo{validInfo}, []string{""})
+	testError(t, err, errAPI.Error(), errEmptyName.Error())
+	_, err = NewUnionInfo([]TypeInfo{validInfo, validInfo}, []string{"hello", "hello"})
+	testError(t, err, errAPI.Error(), duplicateNameErrMsg)
+	_, err = NewUnionInfo([]TypeInfo{validInfo, nil}, []string{"hello", "world"})
+	testError(t, err, errAPI.Error(), interfaceIsNilErrMsg)
 
 	// Invalid interfaces.
 	_, err = NewListInfo(nil)
@@ -277,6 +675,30 @@ func TestErrTypeInfo(t *testing.T) {
 	_, err = NewMapInfo(validInfo, nil)
 	testError(t, err, errAPI.Error(), interfaceIsNilErrMsg)
 
+	// Unsupported MAP key types.
+	listInfo, err := NewListInfo(validInfo)
+	require.NoError(t, err)
+	arrayInfo, err := NewArrayInfo(validInfo, 2)
+	require.NoError(t, err)
+	mapInfo, err := NewMapInfo(validInfo, validInfo)
+	require.NoError(t, err)
+	structInfo, err := NewStructInfo(validStructEntry)
+	require.NoError(t, err)
+	unionInfo, err := NewUnionInfo([]TypeInfo{validInfo}, []string{"v"})
+	require.NoError(t, err)
+	for _, keyInfo := range []TypeInfo{listInfo, arrayInfo, mapInfo, structInfo, unionInfo} {
+		_, err = NewMapInfo(keyInfo, validInfo)
+		testError(t, err, errAPI.Error(), errUnsupportedMapKeyType.Error(), typeToStringMap[keyInfo.InternalType()])
+	}
+
+	// BLOB and UUID keys convert to comparable Go values.
+	for _, keyType := range []Type{TYPE_BLOB, TYPE_UUID} {
+		keyInfo, err := NewTypeInfo(keyType)
+		require.NoError(t, err)
+		_, err = NewMapInfo(keyInfo, validInfo)
+		require.NoError(t, err)
+	}
+
 	_, err = NewArrayInfo(nil, 3)
 	testError(t, err, errAPI.Error(), interfaceIsNilErrMsg)
 }
@@ -6,10 +6,15 @@ package duckdb
 import "C"
 
 import (
+	"bytes"
+	"database/sql/driver"
 	"encoding/binary"
+	"encoding/hex"
 	"fmt"
 	"math/big"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/mitchellh/mapstructure"
 )
@@ -20,15 +25,88 @@ type numericType interface {
 
 const uuid_length = 16
 
+// UUID is the Go representation of a DuckDB UUID value.
 type UUID [uuid_length]byte
 
+// Scan implements the sql.Scanner interface.
+// It accepts the 16 bytes of a UUID, and the hyphenated string representation, e.g., a VARCHAR value.
 func (u *UUID) Scan(v any) error {
-	if n := copy(u[:], v.([]byte)); n != uuid_length {
-		return fmt.Errorf("invalid UUID length: %d", n)
+	switch x := v.(type) {
+	case []byte:
+		if len(x) == uuid_length {
+			copy(u[:], x)
+			return nil
+		}
+		return u.parse(string(x))
+	case string:
+		return u.parse(x)
+	case UUID:
+		*u = x
+		return nil
 	}
+	return castError(fmt.Sprintf("%T", v), "UUID")
+}
+
+// Value implements the driver.Valuer interface. It returns the hyphenated string representation of the UUID.
+func (u UUID) Value() (driver.Value, error) {
+	return u.String(), nil
+}
+
+// String returns the hyphenated string representation of the UUID, e.g., "a7d3e6c0-1b2f-4c5d-8e9f-0a1b2c3d4e5f",
+// which matches DuckDB's representation.
+func (u UUID) String() string {
+	var buf [36]byte
+	hex.Encode(buf[0:8], u[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], u[10:])
+	return string(buf[:])
+}
+
+func (u *UUID) parse(s string) error {
+	digits := strings.ReplaceAll(s, "-", "")
+	if len(digits) != 2*uuid_length {
+		return invalidInputError(strconv.Quote(s), "a UUID")
+	}
+	var parsed UUID
+	if _, err := hex.Decode(parsed[:], []byte(digits)); err != nil {
+		return invalidInputError(strconv.Quote(s), "a UUID")
+	}
+	*u = parsed
 	return nil
 }
 
+// NullUUID is a nullable UUID. It implements the sql.Scanner and the driver.Valuer interface.
+type NullUUID struct {
+	UUID UUID
+	// Valid is true, if the UUID is not NULL.
+	Valid bool
+}
+
+// Scan implements the sql.Scanner interface. A NULL value sets Valid to false.
+func (n *NullUUID) Scan(v any) error {
+	if v == nil {
+		n.UUID, n.Valid = UUID{}, false
+		return nil
+	}
+	n.Valid = true
+	return n.UUID.Scan(v)
+}
+
+// Value implements the driver.Valuer interface.
+// It returns nil, if Valid is false, and the hyphenated string representation of the UUID otherwise.
+func (n NullUUID) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.UUID.Value()
+}
+
 // duckdb_hugeint is composed of (lower, upper) components.
 // The value is computed as: upper * 2^64 + lower
 
@@ -75,6 +153,93 @@ func hugeIntFromNative(i *big.Int) (C.duckdb_hugeint, error) {
 	}, nil
 }
 
+// duckdb_uhugeint is composed of (lower, upper) components.
+// The value is computed as: upper * 2^64 + lower
+
+func uhugeIntToNative(hi C.duckdb_uhugeint) *big.Int {
+	i := new(big.Int).SetUint64(uint64(hi.upper))
+	i.Lsh(i, 64)
+	i.Add(i, new(big.Int).SetUint64(uint64(hi.lower)))
+	return i
+}
+
+func uhugeIntFromNative(i *big.Int) (C.duckdb_uhugeint, error) {
+	if i.Sign() < 0 {
+		return C.duckdb_uhugeint{}, fmt.Errorf("big.Int(%s) is negative, which is not supported for UHUGEINT", i.String())
+	}
+
+	d := big.NewInt(1)
+	d.Lsh(d, 64)
+
+	q := new(big.Int)
+	r := new(big.Int)
+	q.DivMod(i, d, r)
+
+	if !q.IsUint64() {
+		return C.duckdb_uhugeint{}, fmt.Errorf("big.Int(%s) is too big for UHUGEINT", i.String())
+	}
+
+	return C.duckdb_uhugeint{
+		lower: C.uint64_t(r.Uint64()),
+		upper: C.uint64_t(q.Uint64()),
+	}, nil
+}
+
+// BlobReader is a Scanner for BLOB values, which reads the scanned value via io.Reader and io.Seeker.
+// Unlike scanning into a []byte, it does not copy the value again. DuckDB materializes each value as a whole,
+// so the value still resides in memory. A NULL value scans into an empty reader, and sets Valid to false.
+type BlobReader struct {
+	bytes.Reader
+	// Valid is true, if the scanned value is not NULL.
+	Valid bool
+}
+
+// Scan implements the sql.Scanner interface.
+func (b *BlobReader) Scan(v any) error {
+	switch x := v.(type) {
+	case nil:
+		b.Reset(nil)
+		b.Valid = false
+	case []byte:
+		b.Reset(x)
+		b.Valid = true
+	case string:
+		b.Reset([]byte(x))
+		b.Valid = true
+	default:
+		return castError(fmt.Sprintf("%T", v), "BlobReader")
+	}
+	return nil
+}
+
+// TextBuffer is a Scanner for VARCHAR and BLOB values, which copies the scanned value into its buffer Bytes.
+// Scanning into the same TextBuffer reuses the buffer, so scanning many values does not allocate new buffers.
+// Bytes is only valid until the next Scan into the TextBuffer, copy it to retain the value.
+// A NULL value scans into an empty buffer, and sets Valid to false.
+type TextBuffer struct {
+	Bytes []byte
+	// Valid is true, if the scanned value is not NULL.
+	Valid bool
+}
+
+// Scan implements the sql.Scanner interface.
+func (b *TextBuffer) Scan(v any) error {
+	switch x := v.(type) {
+	case nil:
+		b.Bytes = b.Bytes[:0]
+		b.Valid = false
+	case string:
+		b.Bytes = append(b.Bytes[:0], x...)
+		b.Valid = true
+	case []byte:
+		b.Bytes = append(b.Bytes[:0], x...)
+		b.Valid = true
+	default:
+		return castError(fmt.Sprintf("%T", v), "TextBuffer")
+	}
+	return nil
+}
+
 type Map map[any]any
 
 func (m *Map) Scan(v any) error {
@@ -95,12 +260,201 @@ func mapValuesField() string {
 	return "value"
 }
 
+// Interval is the Go representation of a DuckDB INTERVAL value.
+// DuckDB stores intervals as separate months, days, and microseconds components,
+// as the number of days in a month, and the number of microseconds in a day, vary.
+// E.g., INTERVAL 1 YEAR is an Interval with 12 Months.
 type Interval struct {
 	Days   int32 `json:"days"`
 	Months int32 `json:"months"`
 	Micros int64 `json:"micros"`
 }
 
+// Scan implements the sql.Scanner interface.
+func (i *Interval) Scan(v any) error {
+	interval, ok := v.(Interval)
+	if !ok {
+		return castError(fmt.Sprintf("%T", v), "Interval")
+	}
+	*i = interval
+	return nil
+}
+
+// Value implements the driver.Valuer interface. It returns the string representation of the interval.
+// When binding an Interval to a parameter, go-duckdb binds it as an INTERVAL value instead.
+func (i Interval) Value() (driver.Value, error) {
+	return i.String(), nil
+}
+
+// String returns the interval in DuckDB's format, e.g., "1 year 2 months 3 days 04:05:06.000007".
+func (i Interval) String() string {
+	var parts []string
+	appendPart := func(v int64, unit string) {
+		if v == 1 || v == -1 {
+			parts = append(parts, fmt.Sprintf("%d %s", v, unit))
+		} else if v != 0 {
+			parts = append(parts, fmt.Sprintf("%d %ss", v, unit))
+		}
+	}
+	appendPart(int64(i.Months/12), "year")
+	appendPart(int64(i.Months%12), "month")
+	appendPart(int64(i.Days), "day")
+
+	if i.Micros != 0 || len(parts) == 0 {
+		sign := ""
+		micros := uint64(i.Micros)
+		if i.Micros < 0 {
+			sign = "-"
+			micros = uint64(-i.Micros)
+		}
+		const microsPerSecond = uint64(time.Second / time.Microsecond)
+		seconds := micros / microsPerSecond
+		clock := fmt.Sprintf("%s%02d:%02d:%02d", sign, seconds/3600, seconds/60%60, seconds%60)
+		if frac := micros % microsPerSecond; frac != 0 {
+			clock += fmt.Sprintf(".%06d", frac)
+		}
+		parts = append(parts, clock)
+	}
+	return strings.Join(parts, " ")
+}
+
+// NullInterval is a nullable Interval. It implements the sql.Scanner and the driver.Valuer interface.
+type NullInterval struct {
+	Interval Interval
+	// Valid is true, if the Interval is not NULL.
+	Valid bool
+}
+
+// Scan implements the sql.Scanner interface. A NULL value sets Valid to false.
+func (n *NullInterval) Scan(v any) error {
+	if v == nil {
+		n.Interval, n.Valid = Interval{}, false
+		return nil
+	}
+	n.Valid = true
+	return n.Interval.Scan(v)
+}
+
+// Value implements the driver.Valuer interface.
+// It returns nil, if Valid is false, and the string representation of the interval otherwise.
+// When binding a NullInterval to a parameter, go-duckdb binds it as an INTERVAL value instead.
+func (n NullInterval) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Interval.Value()
+}
+
+// Bit is the Go representation of a DuckDB BIT value.
+// It holds the bitstring as a string of '0' and '1' characters, e.g., "0101", which preserves leading zeros.
+type Bit string
+
+// bitFromBlob converts DuckDB's BIT storage format into a Bit.
+// The first byte contains the number of padding bits in the second byte.
+// All following bytes contain the bits, starting with the most significant bit.
+func bitFromBlob(blob []byte) Bit {
+	if len(blob) < 2 {
+		return ""
+	}
+	padding := int(blob[0])
+	data := blob[1:]
+
+	bits := make([]byte, 0, len(data)*8-padding)
+	for i := padding; i < len(data)*8; i++ {
+		if data[i/8]&(1<<(7-i%8)) != 0 {
+			bits = append(bits, '1')
+		} else {
+			bits = append(bits, '0')
+		}
+	}
+	return Bit(bits)
+}
+
+// bitToBlob converts a bitstring into DuckDB's BIT storage format.
+func bitToBlob(bits string) ([]byte, error) {
+	if len(bits) == 0 {
+		return nil, invalidInputError(`""`, "a non-empty bitstring")
+	}
+
+	padding := (8 - len(bits)%8) % 8
+	blob := make([]byte, 1+(len(bits)+padding)/8)
+	blob[0] = byte(padding)
+
+	// DuckDB sets all padding bits to 1.
+	for i := 0; i < padding; i++ {
+		blob[1] |= 1 << (7 - i)
+	}
+	for i, c := range []byte(bits) {
+		pos := padding + i
+		switch c {
+		case '1':
+			blob[1+pos/8] |= 1 << (7 - pos%8)
+		case '0':
+		default:
+			return nil, invalidInputError(strconv.Quote(bits), "a bitstring containing only '0' and '1'")
+		}
+	}
+	return blob, nil
+}
+
+// varintFromBlob converts DuckDB's VARINT storage format into a *big.Int.
+// The first three bytes are a header containing the sign in the most significant bit, and the number of data bytes.
+// All following bytes contain the absolute value in big-endian order.
+// Negative values invert all bits of the header and the data bytes.
+func varintFromBlob(blob []byte) *big.Int {
+	if len(blob) < 3 {
+		return new(big.Int)
+	}
+	negative := blob[0]&0x80 == 0
+	data := blob[3:]
+	if negative {
+		inverted := make([]byte, len(data))
+		for i, b := range data {
+			inverted[i] = ^b
+		}
+		data = inverted
+	}
+
+	v := new(big.Int).SetBytes(data)
+	if negative {
+		v.Neg(v)
+	}
+	return v
+}
+
+// varintToBlob converts a *big.Int into DuckDB's VARINT storage format.
+func varintToBlob(v *big.Int) []byte {
+	data := v.Bytes()
+	// Zero has a single data byte.
+	if len(data) == 0 {
+		data = []byte{0}
+	}
+
+	header := uint32(len(data)) | 0x00800000
+	negative := v.Sign() < 0
+	if negative {
+		header = ^header
+	}
+	blob := make([]byte, 3, 3+len(data))
+	blob[0] = byte(header >> 16)
+	blob[1] = byte(header >> 8)
+	blob[2] = byte(header)
+	for _, b := range data {
+		if negative {
+			b = ^b
+		}
+		blob = append(blob, b)
+	}
+	return blob
+}
+
+// Union is the Go representation of a DuckDB UNION value.
+// Tag is the name of the UNION's active member, and Value holds the member's value.
+type Union struct {
+	Tag   string `json:"tag"`
+	Value any    `json:"value"`
+}
+
 // Use as the `Scanner` type for any composite types (maps, lists, structs)
 type Composite[T any] struct {
 	t T
@@ -116,12 +470,86 @@ func (s *Composite[T]) Scan(v any) error {
 
 const max_decimal_width = 38
 
+// Decimal is the Go representation of a DuckDB DECIMAL value.
+// Value holds the unscaled value, i.e., the represented number is Value * 10^(-Scale).
+// Width and Scale match the DECIMAL type of the value, e.g., DECIMAL(5, 2) has a Width of 5 and a Scale of 2.
+// Decimal parameters bind as DECIMAL values without loss of precision.
+// The Value field prevents Decimal from implementing the driver.Valuer interface, so other drivers
+// cannot bind it. Use NullDecimal, which implements driver.Valuer, where a driver.Valuer is required.
 type Decimal struct {
 	Width uint8
 	Scale uint8
 	Value *big.Int
 }
 
+// Scan implements the sql.Scanner interface.
+// It accepts DECIMAL values, integer values, and strings containing a decimal number, e.g., "-12.340".
+// The Scale of a string is the number of digits after the decimal point.
+func (d *Decimal) Scan(v any) error {
+	switch x := v.(type) {
+	case Decimal:
+		if x.Value == nil {
+			return castError("Decimal(nil)", "Decimal")
+		}
+		*d = Decimal{Width: x.Width, Scale: x.Scale, Value: new(big.Int).Set(x.Value)}
+		return nil
+	case *big.Int:
+		if x == nil {
+			return castError("*big.Int(nil)", "Decimal")
+		}
+		return d.fromInteger(new(big.Int).Set(x))
+	case int64:
+		return d.fromInteger(big.NewInt(x))
+	case string:
+		return d.parse(x)
+	case []byte:
+		return d.parse(string(x))
+	}
+	return castError(fmt.Sprintf("%T", v), "Decimal")
+}
+
+func (d *Decimal) fromInteger(v *big.Int) error {
+	width := len(new(big.Int).Abs(v).String())
+	if width > max_decimal_width {
+		return invalidInputError(v.String(), "a value fitting into DECIMAL("+strconv.Itoa(max_decimal_width)+",0)")
+	}
+	*d = Decimal{Width: uint8(width), Scale: 0, Value: v}
+	return nil
+}
+
+func (d *Decimal) parse(s string) error {
+	// Strip at most one sign, so that the digits must not contain another one.
+	digits := s
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		digits = s[1:]
+	}
+	intPart, fracPart, _ := strings.Cut(digits, ".")
+	if intPart == "" && fracPart == "" {
+		return invalidInputError(strconv.Quote(s), "a decimal number")
+	}
+	if intPart == "" {
+		intPart = "0"
+	}
+
+	value, ok := new(big.Int).SetString(intPart+fracPart, 10)
+	if !ok || strings.ContainsAny(intPart+fracPart, "+-_") {
+		return invalidInputError(strconv.Quote(s), "a decimal number")
+	}
+	if strings.HasPrefix(s, "-") {
+		value.Neg(value)
+	}
+
+	width := len(strings.TrimLeft(intPart, "0")) + len(fracPart)
+	if width == 0 {
+		width = 1
+	}
+	if width > max_decimal_width {
+		return invalidInputError(strconv.Quote(s), "a value fitting into DECIMAL("+strconv.Itoa(max_decimal_width)+",s)")
+	}
+	*d = Decimal{Width: uint8(width), Scale: uint8(len(fracPart)), Value: value}
+	return nil
+}
+
 func (d *Decimal) Float64() float64 {
 	scale := big.NewInt(int64(d.Scale))
 	factor := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), scale, nil))
@@ -160,3 +588,33 @@ func (d *Decimal) String() string {
 	}
 	return signStr + zeroTrimmed[:len(zeroTrimmed)-scale] + "." + zeroTrimmed[len(zeroTrimmed)-scale:]
 }
+
+// NullDecimal is a nullable Decimal. It implements the sql.Scanner and the driver.Valuer interface.
+type NullDecimal struct {
+	Decimal Decimal
+	// Valid is true, if the Decimal is not NULL.
+	Valid bool
+}
+
+// Scan implements the sql.Scanner interface. A NULL value sets Valid to false.
+func (n *NullDecimal) Scan(v any) error {
+	if v == nil {
+		n.Decimal, n.Valid = Decimal{}, false
+		return nil
+	}
+	n.Valid = true
+	return n.Decimal.Scan(v)
+}
+
+// Value implements the driver.Valuer interface.
+// It returns nil, if Valid is false, and the string representation of the decimal otherwise.
+// When binding a NullDecimal to a parameter, go-duckdb binds it as a DECIMAL value instead.
+func (n NullDecimal) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	if n.Decimal.Value == nil {
+		return nil, castError("Decimal(nil)", "NullDecimal")
+	}
+	return n.Decimal.String(), nil
+}
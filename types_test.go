@@ -4,9 +4,16 @@ import (
 	"bytes"
 	"context"
 	"database/sql"
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/json"
 	"fmt"
+	"io"
+	"math"
 	"math/big"
+	"reflect"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -300,6 +307,7 @@ func TestTypes(t *testing.T) {
 
 	for i := range actualRows {
 		expectedRows[i].toUTC()
+		actualRows[i].toUTC()
 		require.Equal(t, expectedRows[i], actualRows[i])
 	}
 
@@ -444,6 +452,68 @@ func TestDecimal(t *testing.T) {
 		}
 	})
 
+	t.Run("DECIMAL binding", func(t *testing.T) {
+		_, err := db.Exec(`CREATE TABLE decimal_test (d DECIMAL(38, 10))`)
+		require.NoError(t, err)
+
+		// The value exceeds the precision of a float64.
+		value, ok := new(big.Int).SetString("-1234567890123456789012345678", 10)
+		require.True(t, ok)
+		d := Decimal{Width: 38, Scale: 10, Value: value}
+		_, err = db.Exec(`INSERT INTO decimal_test VALUES (?)`, d)
+		require.NoError(t, err)
+
+		var res Decimal
+		require.NoError(t, db.QueryRow(`SELECT d FROM decimal_test WHERE d = ?`, d).Scan(&res))
+		compareDecimal(t, d, res)
+
+		var typeName string
+		require.NoError(t, db.QueryRow(`SELECT typeof(?)`, Decimal{Width: 5, Scale: 2, Value: big.NewInt(1)}).Scan(&typeName))
+		require.Equal(t, "DECIMAL(5,2)", typeName)
+
+		_, err = db.Exec(`INSERT INTO decimal_test VALUES (?)`, Decimal{Width: 2, Scale: 3, Value: big.NewInt(1)})
+		require.ErrorContains(t, err, errCouldNotBind.Error())
+	})
+
+	t.Run("Decimal Scanner", func(t *testing.T) {
+		tests := []struct {
+			input any
+			want  Decimal
+		}{
+			{input: "1.23", want: Decimal{Width: 3, Scale: 2, Value: big.NewInt(123)}},
+			{input: "-0.050", want: Decimal{Width: 3, Scale: 3, Value: big.NewInt(-50)}},
+			{input: "42", want: Decimal{Width: 2, Scale: 0, Value: big.NewInt(42)}},
+			{input: []byte("7.5"), want: Decimal{Width: 2, Scale: 1, Value: big.NewInt(75)}},
+			{input: ".5", want: Decimal{Width: 1, Scale: 1, Value: big.NewInt(5)}},
+			{input: "-5.", want: Decimal{Width: 1, Scale: 0, Value: big.NewInt(-5)}},
+			{input: int64(-42), want: Decimal{Width: 2, Scale: 0, Value: big.NewInt(-42)}},
+			{input: big.NewInt(100), want: Decimal{Width: 3, Scale: 0, Value: big.NewInt(100)}},
+		}
+		for _, test := range tests {
+			var d Decimal
+			require.NoError(t, d.Scan(test.input))
+			require.Equal(t, test.want, d)
+		}
+
+		var d Decimal
+		require.ErrorContains(t, d.Scan("1.2.3"), invalidInputErrMsg)
+		// A decimal number has at most one sign.
+		for _, s := range []string{"--1", "-+1", "+-1"} {
+			require.ErrorContains(t, d.Scan(s), invalidInputErrMsg)
+		}
+		// A decimal number requires at least one digit.
+		for _, s := range []string{"", "-", "+", ".", "-."} {
+			require.ErrorContains(t, d.Scan(s), invalidInputErrMsg)
+		}
+		require.ErrorContains(t, d.Scan(1.5), castErrMsg)
+		require.ErrorContains(t, d.Scan(nil), castErrMsg)
+
+		// Scan a VARCHAR column into a Decimal.
+		require.NoError(t, db.QueryRow(`SELECT '123456789012345678901234567.89'`).Scan(&d))
+		require.Equal(t, "123456789012345678901234567.89", d.String())
+		require.Equal(t, uint8(2), d.Scale)
+	})
+
 	require.NoError(t, db.Close())
 }
 
@@ -603,6 +673,201 @@ func TestBlob(t *testing.T) {
 	require.NoError(t, db.Close())
 }
 
+func TestBlobEncoding(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+
+	const query = `SELECT '\xAA\x01'::BLOB`
+	tests := []struct {
+		encoding BlobEncoding
+		expected string
+	}{
+		{BlobRaw, "\xaa\x01"},
+		{BlobHex, "aa01"},
+		{BlobBase64, "qgE="},
+	}
+	for _, test := range tests {
+		s := BlobString{ScanOptions: ScanOptions{BlobEncoding: test.encoding}}
+		require.NoError(t, db.QueryRow(query).Scan(&s))
+		require.Equal(t, test.expected, s.Get())
+
+		// The encoding applies to nested string destinations.
+		l := List[string]{ScanOptions: ScanOptions{BlobEncoding: test.encoding, NullElements: NullElementsZero}}
+		require.NoError(t, db.QueryRow(`SELECT ['\xAA\x01'::BLOB, NULL]`).Scan(&l))
+		require.Equal(t, []string{test.expected, ""}, l.Get())
+	}
+
+	// []byte destinations always scan the raw bytes.
+	bytes := List[[]byte]{ScanOptions: ScanOptions{BlobEncoding: BlobBase64}}
+	require.NoError(t, db.QueryRow(`SELECT ['\xAA\x01'::BLOB]`).Scan(&bytes))
+	require.Equal(t, [][]byte{{0xAA, 0x01}}, bytes.Get())
+
+	// A NULL value scans into an empty string.
+	s := BlobString{ScanOptions: ScanOptions{BlobEncoding: BlobHex}}
+	require.NoError(t, db.QueryRow(`SELECT NULL::BLOB`).Scan(&s))
+	require.Empty(t, s.Get())
+
+	require.NoError(t, db.Close())
+}
+
+func TestTextBuffer(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+
+	// Scanning VARCHAR values into a TextBuffer reuses its buffer.
+	res, err := db.Query(`SELECT CASE WHEN range = 5 THEN NULL ELSE repeat(range::VARCHAR, 10) END FROM range(10) ORDER BY range`)
+	require.NoError(t, err)
+	buf := TextBuffer{Bytes: make([]byte, 0, 128)}
+	i := 0
+	for res.Next() {
+		require.NoError(t, res.Scan(&buf))
+		if i == 5 {
+			require.False(t, buf.Valid)
+			require.Empty(t, buf.Bytes)
+		} else {
+			require.True(t, buf.Valid)
+			require.Equal(t, strings.Repeat(strconv.Itoa(i), 10), string(buf.Bytes))
+		}
+		require.Equal(t, 128, cap(buf.Bytes))
+		i++
+	}
+	require.Equal(t, 10, i)
+	require.NoError(t, res.Close())
+	require.NoError(t, db.Close())
+}
+
+func TestRawText(t *testing.T) {
+	t.Parallel()
+	connector, err := NewConnectorWithConfig("", ConnectorConfig{RawText: true})
+	require.NoError(t, err)
+	db := sql.OpenDB(connector)
+
+	// VARCHAR columns return []byte values, which reference DuckDB's memory.
+	res, err := db.Query(`SELECT repeat(range::VARCHAR, range) AS s, NULL::VARCHAR AS n, '{"a": 42}'::JSON AS j, ['x'] AS l
+		FROM range(3000) ORDER BY range`)
+	require.NoError(t, err)
+	cols, err := res.ColumnTypes()
+	require.NoError(t, err)
+	require.Equal(t, reflect.TypeOf([]byte{}), cols[0].ScanType())
+
+	var raw sql.RawBytes
+	var buf TextBuffer
+	i := 0
+	for res.Next() {
+		var n, j, l any
+		expected := strings.Repeat(strconv.Itoa(i), i)
+
+		// Destinations other than sql.RawBytes copy the value.
+		var s string
+		var anyS any
+		require.NoError(t, res.Scan(&s, &n, &j, &l))
+		require.Equal(t, expected, s)
+		require.NoError(t, res.Scan(&anyS, &n, &j, &l))
+		require.Equal(t, []byte(expected), anyS)
+		require.NoError(t, res.Scan(&buf, &n, &j, &l))
+		require.Equal(t, expected, string(buf.Bytes))
+
+		// NULL, JSON, and nested values are not affected.
+		require.Nil(t, n)
+		require.Equal(t, map[string]any{"a": float64(42)}, j)
+		require.Equal(t, []any{"x"}, l)
+
+		// Scanning into a sql.RawBytes must be the last scan of the row.
+		require.NoError(t, res.Scan(&raw, &n, &j, &l))
+		require.Equal(t, expected, string(raw))
+		i++
+	}
+	require.Equal(t, 3000, i)
+	require.NoError(t, res.Close())
+	require.NoError(t, db.Close())
+}
+
+func TestRawJSON(t *testing.T) {
+	t.Parallel()
+	connector, err := NewConnectorWithConfig("", ConnectorConfig{RawJSON: true})
+	require.NoError(t, err)
+	db := sql.OpenDB(connector)
+
+	// JSON values keep their text.
+	res, err := db.Query(`SELECT '{"a":1}'::JSON AS j, {'s': '{"b": [1.50, 2]}'::JSON} AS s, NULL::JSON AS n`)
+	require.NoError(t, err)
+	cols, err := res.ColumnTypes()
+	require.NoError(t, err)
+	require.Equal(t, reflect.TypeOf(json.RawMessage{}), cols[0].ScanType())
+
+	require.True(t, res.Next())
+	var j json.RawMessage
+	var s, n any
+	require.NoError(t, res.Scan(&j, &s, &n))
+	require.Equal(t, json.RawMessage(`{"a":1}`), j)
+	require.Equal(t, map[string]any{"s": json.RawMessage(`{"b": [1.50, 2]}`)}, s)
+	require.Nil(t, n)
+	require.NoError(t, res.Close())
+
+	require.NoError(t, db.Close())
+
+	// Appending a json.RawMessage keeps its text, too.
+	c, con, a := prepareAppender(t, `CREATE TABLE test (j JSON, s STRUCT(j JSON))`)
+	require.NoError(t, a.AppendRow(json.RawMessage(`{"a": 1}`), map[string]any{"j": json.RawMessage(`[1, "x"]`)}))
+	require.ErrorContains(t, a.AppendRow(json.RawMessage(`{"a":`), nil), "a valid JSON value")
+	require.NoError(t, a.Flush())
+
+	var text, nested string
+	err = sql.OpenDB(c).QueryRow(`SELECT j::VARCHAR, s.j::VARCHAR FROM test`).Scan(&text, &nested)
+	require.NoError(t, err)
+	require.Equal(t, `{"a": 1}`, text)
+	require.Equal(t, `[1, "x"]`, nested)
+	cleanupAppender(t, c, con, a)
+}
+
+func BenchmarkScanVarchar(b *testing.B) {
+	db, err := sql.Open("duckdb", "")
+	require.NoError(b, err)
+	query := `SELECT repeat('x', 100) FROM range(100000)`
+
+	b.Run("string", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			res, err := db.Query(query)
+			require.NoError(b, err)
+			var s string
+			for res.Next() {
+				require.NoError(b, res.Scan(&s))
+			}
+			require.NoError(b, res.Close())
+		}
+	})
+
+	b.Run("TextBuffer", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			res, err := db.Query(query)
+			require.NoError(b, err)
+			var buf TextBuffer
+			for res.Next() {
+				require.NoError(b, res.Scan(&buf))
+			}
+			require.NoError(b, res.Close())
+		}
+	})
+	require.NoError(b, db.Close())
+
+	connector, err := NewConnectorWithConfig("", ConnectorConfig{RawText: true})
+	require.NoError(b, err)
+	db = sql.OpenDB(connector)
+
+	b.Run("RawText", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			res, err := db.Query(query)
+			require.NoError(b, err)
+			var buf TextBuffer
+			for res.Next() {
+				require.NoError(b, res.Scan(&buf))
+			}
+			require.NoError(b, res.Close())
+		}
+	})
+	require.NoError(b, db.Close())
+}
+
 func TestList(t *testing.T) {
 	t.Parallel()
 	db := openDB(t)
@@ -618,6 +883,464 @@ func TestList(t *testing.T) {
 	require.NoError(t, db.Close())
 }
 
+func TestTypedList(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+
+	const query = `SELECT [[4::DECIMAL(3, 2), NULL], NULL, [], [-1.25::DECIMAL(3, 2)]]`
+
+	// Scan a nested DECIMAL LIST into typed slices.
+	var rats List[[]*big.Rat]
+	require.NoError(t, db.QueryRow(query).Scan(&rats))
+	require.Equal(t, [][]*big.Rat{{big.NewRat(4, 1), nil}, nil, {}, {big.NewRat(-5, 4)}}, rats.Get())
+
+	var decimals List[[]*Decimal]
+	require.NoError(t, db.QueryRow(query).Scan(&decimals))
+	require.Len(t, decimals.Get(), 4)
+	require.Equal(t, "4", decimals.Get()[0][0].String())
+	require.Nil(t, decimals.Get()[0][1])
+
+	var floats List[[]*float64]
+	require.NoError(t, db.QueryRow(query).Scan(&floats))
+	require.Equal(t, -1.25, *floats.Get()[3][0])
+
+	// NULL elements require a nilable element type.
+	var strs List[[]string]
+	err := db.QueryRow(query).Scan(&strs)
+	require.ErrorContains(t, err, castErrMsg)
+	require.NoError(t, db.QueryRow(`SELECT [[4::DECIMAL(3, 2), 1.5], [], NULL]`).Scan(&strs))
+	require.Equal(t, [][]string{{"4", "1.5"}, {}, nil}, strs.Get())
+
+	// Integers convert to any integer type that holds them.
+	var ints List[[2]int64]
+	require.NoError(t, db.QueryRow(`SELECT [[1::TINYINT, 2], [3, 4]]`).Scan(&ints))
+	require.Equal(t, [][2]int64{{1, 2}, {3, 4}}, ints.Get())
+
+	var small List[uint8]
+	err = db.QueryRow(`SELECT [1, 256]`).Scan(&small)
+	require.ErrorContains(t, err, castErrMsg)
+	err = db.QueryRow(`SELECT [1, -1]`).Scan(&small)
+	require.ErrorContains(t, err, castErrMsg)
+
+	// A NULL LIST scans into a nil slice.
+	require.NoError(t, db.QueryRow(`SELECT NULL::INT[]`).Scan(&ints))
+	require.Nil(t, ints.Get())
+
+	require.NoError(t, db.Close())
+}
+
+func TestTypedNullElements(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+
+	const query = `SELECT [['a', NULL], NULL, [NULL, 'b']]`
+
+	// NULL elements scan into the zero value, but NULL LIST values still scan into nil slices.
+	zero := List[[]string]{ScanOptions: ScanOptions{NullElements: NullElementsZero}}
+	require.NoError(t, db.QueryRow(query).Scan(&zero))
+	require.Equal(t, [][]string{{"a", ""}, nil, {"", "b"}}, zero.Get())
+
+	skip := List[[]string]{ScanOptions: ScanOptions{NullElements: NullElementsSkip}}
+	require.NoError(t, db.QueryRow(query).Scan(&skip))
+	require.Equal(t, [][]string{{"a"}, nil, {"b"}}, skip.Get())
+
+	// Nilable element types ignore the mode.
+	ptrs := List[[]*string]{ScanOptions: ScanOptions{NullElements: NullElementsSkip}}
+	require.NoError(t, db.QueryRow(query).Scan(&ptrs))
+	require.Len(t, ptrs.Get()[0], 2)
+	require.Nil(t, ptrs.Get()[0][1])
+
+	// Go arrays keep their length.
+	arr := List[[2]int32]{ScanOptions: ScanOptions{NullElements: NullElementsSkip}}
+	require.NoError(t, db.QueryRow(`SELECT [[1, NULL], [NULL, 4]]`).Scan(&arr))
+	require.Equal(t, [][2]int32{{1, 0}, {0, 4}}, arr.Get())
+
+	m := TypedMap[string, int32]{ScanOptions: ScanOptions{NullElements: NullElementsSkip}}
+	require.NoError(t, db.QueryRow(`SELECT MAP {'a': 1, 'b': NULL}`).Scan(&m))
+	require.Equal(t, map[string]int32{"a": 1}, m.Get())
+
+	m.NullElements = NullElementsZero
+	require.NoError(t, db.QueryRow(`SELECT MAP {'a': 1, 'b': NULL}`).Scan(&m))
+	require.Equal(t, map[string]int32{"a": 1, "b": 0}, m.Get())
+
+	m.NullElements = NullElementsError
+	err := db.QueryRow(`SELECT MAP {'a': 1, 'b': NULL}`).Scan(&m)
+	require.ErrorContains(t, err, castErrMsg)
+
+	require.NoError(t, db.Close())
+}
+
+func TestTypedStruct(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	_, err := db.Exec(`CREATE TYPE greeting AS ENUM ('hello', 'world', '!')`)
+	require.NoError(t, err)
+
+	type inner struct {
+		Greeting string       `db:"hello"`
+		World    [][]*big.Rat `db:"world"`
+	}
+	type outer struct {
+		Hello inner          `db:"hello"`
+		World []float64      `db:"world"`
+		Other *inner         `db:"other"`
+		Tags  map[string]any `db:"tags"`
+		Names List[string]   `db:"names"`
+		Zero  int
+	}
+
+	const query = `SELECT {
+		'hello': {'hello': 'hello'::greeting, 'world': [[4::DECIMAL(3, 2)]]},
+		'world': [4::DECIMAL(3, 2)],
+		'other': NULL::STRUCT(hello greeting, world DECIMAL(3, 2)[][]),
+		'tags': {'a': 1},
+		'names': ['x', 'y'],
+		'unknown': 42
+	}`
+
+	var s Struct[outer]
+	require.NoError(t, db.QueryRow(query).Scan(&s))
+	res := s.Get()
+	require.Equal(t, "hello", res.Hello.Greeting)
+	require.Equal(t, [][]*big.Rat{{big.NewRat(4, 1)}}, res.Hello.World)
+	require.Equal(t, []float64{4}, res.World)
+	require.Nil(t, res.Other)
+	require.Equal(t, map[string]any{"a": int32(1)}, res.Tags)
+	require.Equal(t, []string{"x", "y"}, res.Names.Get())
+	require.Zero(t, res.Zero)
+
+	// Strict scanning rejects unknown and missing fields.
+	strict := Struct[outer]{ScanOptions: ScanOptions{Strict: true}}
+	err = db.QueryRow(query).Scan(&strict)
+	require.ErrorContains(t, err, structFieldErrMsg)
+
+	type exact struct {
+		A int32
+		B *string
+	}
+	strictExact := Struct[exact]{ScanOptions: ScanOptions{Strict: true}}
+	require.NoError(t, db.QueryRow(`SELECT {'A': 1, 'B': NULL::VARCHAR}`).Scan(&strictExact))
+	require.Equal(t, exact{A: 1}, strictExact.Get())
+	err = db.QueryRow(`SELECT {'A': 1}`).Scan(&strictExact)
+	require.ErrorContains(t, err, "missing field")
+
+	// Conversion errors name the field.
+	err = db.QueryRow(`SELECT {'A': 'one'}`).Scan(&strictExact)
+	require.ErrorContains(t, err, castErrMsg)
+	require.ErrorContains(t, err, "field: A")
+
+	require.NoError(t, db.Close())
+}
+
+func TestMapKeyTypes(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+
+	// BLOB keys scan as strings.
+	var m Map
+	require.NoError(t, db.QueryRow(`SELECT MAP {'\x01'::BLOB: 1, 'ab'::BLOB: 2}`).Scan(&m))
+	require.Equal(t, Map{"\x01": int32(1), "ab": int32(2)}, m)
+
+	// UUID keys scan as UUID values.
+	const id = "a7d3e6c0-1b2f-4c5d-8e9f-0a1b2c3d4e5f"
+	require.NoError(t, db.QueryRow(`SELECT MAP {?::UUID: 'x'}`, id).Scan(&m))
+	var u UUID
+	require.NoError(t, u.Scan(id))
+	require.Equal(t, Map{u: "x"}, m)
+
+	var uuids TypedMap[UUID, string]
+	require.NoError(t, db.QueryRow(`SELECT MAP {?::UUID: 'x'}`, id).Scan(&uuids))
+	require.Equal(t, map[UUID]string{u: "x"}, uuids.Get())
+	require.NoError(t, db.Close())
+}
+
+func TestTypedMap(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+
+	type entry struct {
+		A int32   `db:"a"`
+		B *string `db:"b"`
+	}
+	const query = `SELECT MAP {
+		1.5::DECIMAL(3, 2): {'a': 1, 'b': 'x'},
+		2::DECIMAL(3, 2): {'a': 2, 'b': NULL},
+		3::DECIMAL(3, 2): NULL
+	}`
+
+	var strs TypedMap[string, *entry]
+	require.NoError(t, db.QueryRow(query).Scan(&strs))
+	x := "x"
+	require.Equal(t, map[string]*entry{
+		"1.5": {A: 1, B: &x},
+		"2":   {A: 2},
+		"3":   nil,
+	}, strs.Get())
+
+	var floats TypedMap[float64, *entry]
+	require.NoError(t, db.QueryRow(query).Scan(&floats))
+	require.Len(t, floats.Get(), 3)
+	require.Equal(t, int32(1), floats.Get()[1.5].A)
+
+	// Pointer keys compare by address.
+	var rats TypedMap[*big.Rat, *entry]
+	require.NoError(t, db.QueryRow(query).Scan(&rats))
+	for k, v := range rats.Get() {
+		if k.Cmp(big.NewRat(2, 1)) == 0 {
+			require.Equal(t, int32(2), v.A)
+		}
+	}
+
+	// Nested maps and lists convert recursively.
+	var nested TypedMap[string, []map[int64]string]
+	require.NoError(t, db.QueryRow(`SELECT MAP {'k': [MAP {1: 'a'}, NULL]}`).Scan(&nested))
+	require.Equal(t, map[string][]map[int64]string{"k": {{1: "a"}, nil}}, nested.Get())
+
+	// A NULL value requires a nillable value type.
+	var values TypedMap[string, entry]
+	err := db.QueryRow(query).Scan(&values)
+	require.ErrorContains(t, err, castErrMsg)
+	require.ErrorContains(t, err, "key: ")
+
+	// Multiple keys convert into the same Go key.
+	var lossy TypedMap[float32, string]
+	err = db.QueryRow(`SELECT MAP {1::DOUBLE: 'a', 1.0000000001::DOUBLE: 'b'}`).Scan(&lossy)
+	require.ErrorContains(t, err, invalidInputErrMsg)
+	require.ErrorContains(t, err, "duplicate key 1")
+
+	require.NoError(t, db.Close())
+}
+
+func TestTypedStructCaseInsensitive(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+
+	type fields struct {
+		Hello string
+		World int32 `db:"WORLD"`
+	}
+
+	// By default, the field names must match exactly.
+	var s Struct[fields]
+	require.NoError(t, db.QueryRow(`SELECT {'hello': 'a', 'world': 1}`).Scan(&s))
+	require.Equal(t, fields{}, s.Get())
+
+	opts := ScanOptions{Strict: true, CaseInsensitive: true}
+	s = Struct[fields]{ScanOptions: opts}
+	require.NoError(t, db.QueryRow(`SELECT {'hello': 'a', 'World': 1}`).Scan(&s))
+	require.Equal(t, fields{Hello: "a", World: 1}, s.Get())
+
+	err := db.QueryRow(`SELECT {'hello': 'a'}`).Scan(&s)
+	require.ErrorContains(t, err, "missing field")
+
+	// Multiple Go struct fields match the same STRUCT field.
+	type duplicates struct {
+		Name string
+		NAME string
+	}
+	d := Struct[duplicates]{ScanOptions: ScanOptions{CaseInsensitive: true}}
+	err = db.QueryRow(`SELECT {'name': 'a'}`).Scan(&d)
+	require.ErrorContains(t, err, structFieldErrMsg)
+	require.ErrorContains(t, err, "ambiguous field")
+
+	require.NoError(t, db.Close())
+}
+
+func TestScanAll(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+
+	type Base struct {
+		ID int64 `db:"id"`
+	}
+	type Audit struct {
+		Created time.Time `db:"created"`
+	}
+	type tag struct {
+		Name  string
+		Score float64
+	}
+	type row struct {
+		Base
+		*Audit
+		Name    *string  `db:"name"`
+		Tags    []tag    `db:"tags"`
+		Price   *big.Rat `db:"price"`
+		Comment string
+	}
+
+	ts := time.Date(2024, time.January, 2, 3, 4, 5, 0, time.UTC)
+	rows, err := db.Query(`SELECT * FROM (VALUES
+		(1, 'a', [{'Name': 'x', 'Score': 1.5}], 1.25::DECIMAL(4,2), ?::TIMESTAMP),
+		(2, NULL, [], NULL, ?::TIMESTAMP)
+	) t(id, name, tags, price, created) ORDER BY id`, ts, ts)
+	require.NoError(t, err)
+
+	results, err := ScanAll[row](rows)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	require.Equal(t, int64(1), results[0].ID)
+	require.Equal(t, ts, results[0].Created)
+	require.Equal(t, "a", *results[0].Name)
+	require.Equal(t, []tag{{Name: "x", Score: 1.5}}, results[0].Tags)
+	require.Equal(t, big.NewRat(5, 4), results[0].Price)
+	require.Empty(t, results[0].Comment)
+
+	require.Equal(t, int64(2), results[1].ID)
+	require.Nil(t, results[1].Name)
+	require.Equal(t, []tag{}, results[1].Tags)
+	require.Nil(t, results[1].Price)
+
+	// A column without a matching field.
+	rows, err = db.Query(`SELECT 1 AS id, 2 AS other`)
+	require.NoError(t, err)
+	_, err = ScanAll[row](rows)
+	testError(t, err, errAPI.Error(), structFieldErrMsg, "unknown column other")
+
+	// ScanAll returns the rows before the failing row.
+	rows, err = db.Query(`SELECT * FROM (VALUES (1, 'a'), (2, NULL)) t(id, Comment) ORDER BY id`)
+	require.NoError(t, err)
+	results, err = ScanAll[row](rows)
+	require.Error(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, "a", results[0].Comment)
+
+	rows, err = db.Query(`SELECT 1`)
+	require.NoError(t, err)
+	_, err = ScanAll[int](rows)
+	testError(t, err, errAPI.Error(), castErrMsg)
+	require.NoError(t, db.Close())
+}
+
+func TestScanMap(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+
+	rows, err := db.Query(`SELECT 1::INTEGER AS i, 'a' AS s, NULL::VARCHAR AS n, [1, 2] AS l, {'x': [1.5::DOUBLE]} AS st,
+		MAP {'k': 1} AS m, 1.25::DECIMAL(4, 2) AS d, DATE '2024-01-02' AS dt, 42::HUGEINT AS h, 'b'::BLOB AS b
+		FROM range(2)`)
+	require.NoError(t, err)
+
+	count := 0
+	for rows.Next() {
+		m, err := ScanMap(rows)
+		require.NoError(t, err)
+		require.Len(t, m, 10)
+		require.Equal(t, int32(1), m["i"])
+		require.Equal(t, "a", m["s"])
+		require.Contains(t, m, "n")
+		require.Nil(t, m["n"])
+		require.Equal(t, []any{int32(1), int32(2)}, m["l"])
+		require.Equal(t, map[string]any{"x": []any{1.5}}, m["st"])
+		require.Equal(t, Map{"k": int32(1)}, m["m"])
+		require.Equal(t, Decimal{Width: 4, Scale: 2, Value: big.NewInt(125)}, m["d"])
+		require.Equal(t, time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC), m["dt"])
+		require.Equal(t, "42", m["h"].(*big.Int).String())
+		require.Equal(t, []byte("b"), m["b"])
+		count++
+	}
+	require.NoError(t, rows.Err())
+	require.NoError(t, rows.Close())
+	require.Equal(t, 2, count)
+
+	// The column names must be unique.
+	rows, err = db.Query(`SELECT 1 AS a, 2 AS a`)
+	require.NoError(t, err)
+	require.True(t, rows.Next())
+	_, err = ScanMap(rows)
+	testError(t, err, errAPI.Error(), duplicateNameErrMsg, "a")
+	require.NoError(t, rows.Close())
+
+	// ScanMap requires a current row.
+	rows, err = db.Query(`SELECT 1`)
+	require.NoError(t, err)
+	_, err = ScanMap(rows)
+	require.Error(t, err)
+	require.NoError(t, rows.Close())
+	require.NoError(t, db.Close())
+}
+
+func TestScanColumn(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	conn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+
+	err = conn.Raw(func(driverConn any) error {
+		r, err := driverConn.(*Conn).QueryContext(context.Background(), `SELECT i, i / 2, CASE WHEN i % 2 = 0 THEN i::INTEGER END
+			FROM range(5000) t(i) ORDER BY i`, nil)
+		require.NoError(t, err)
+
+		err = ScanColumn(r, 0, make([]int64, 0))
+		testError(t, err, errAPI.Error(), errNoChunk.Error())
+
+		var rowCount, sum int64
+		var halves float64
+		var evens int32
+		for {
+			size, err := NextChunk(r)
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			ids, doubles, ints := make([]int64, size), make([]float64, size), make([]int32, size)
+			require.NoError(t, ScanColumn(r, 0, ids))
+			require.NoError(t, ScanColumn(r, 1, doubles))
+			require.NoError(t, ScanColumn(r, 2, ints))
+
+			for i := 0; i < size; i++ {
+				require.Equal(t, rowCount, ids[i])
+				sum += ids[i]
+				halves += doubles[i]
+				evens += ints[i]
+				// NULL values are zero values.
+				if rowCount%2 == 1 {
+					require.Zero(t, ints[i])
+				}
+				rowCount++
+			}
+		}
+		require.Equal(t, int64(5000), rowCount)
+		require.Equal(t, int64(12497500), sum)
+		require.Equal(t, 6248750.0, halves)
+		require.Equal(t, int32(6247500), evens)
+		return r.Close()
+	})
+	require.NoError(t, err)
+
+	// Next continues after the chunk, and ScanColumn validates the destination.
+	err = conn.Raw(func(driverConn any) error {
+		r, err := driverConn.(*Conn).QueryContext(context.Background(), `SELECT i FROM range(3000) t(i) ORDER BY i`, nil)
+		require.NoError(t, err)
+
+		size, err := NextChunk(r)
+		require.NoError(t, err)
+		values := make([]driver.Value, 1)
+		require.NoError(t, r.Next(values))
+		require.Equal(t, int64(size), values[0])
+
+		err = ScanColumn(r, 0, make([]int64, size+1))
+		testError(t, err, errAPI.Error(), invalidInputErrMsg)
+		err = ScanColumn(r, 0, make([]int32, size))
+		testError(t, err, errAPI.Error(), castErrMsg)
+		err = ScanColumn(r, 0, make([]string, size))
+		testError(t, err, errAPI.Error(), castErrMsg)
+		err = ScanColumn(r, 1, make([]int64, size))
+		testError(t, err, errAPI.Error(), columnCountErrMsg)
+		return r.Close()
+	})
+	require.NoError(t, err)
+
+	_, err = NextChunk(nil)
+	testError(t, err, errAPI.Error(), errInvalidRows.Error())
+	err = ScanColumn(nil, 0, nil)
+	testError(t, err, errAPI.Error(), errInvalidRows.Error())
+
+	require.NoError(t, conn.Close())
+	require.NoError(t, db.Close())
+}
+
 func TestUUID(t *testing.T) {
 	t.Parallel()
 	db := openDB(t)
@@ -645,6 +1368,89 @@ func TestUUID(t *testing.T) {
 		require.Equal(t, test, val)
 	}
 
+	t.Run("UUID type", func(t *testing.T) {
+		id := UUID(uuid.New())
+		_, err = db.Exec(`INSERT INTO uuid_test VALUES(?)`, id)
+		require.NoError(t, err)
+
+		var val UUID
+		require.NoError(t, db.QueryRow(`SELECT uuid FROM uuid_test WHERE uuid = ?`, id).Scan(&val))
+		require.Equal(t, id, val)
+
+		// The string representation matches DuckDB's representation.
+		var str string
+		require.NoError(t, db.QueryRow(`SELECT uuid::VARCHAR FROM uuid_test WHERE uuid = ?`, id).Scan(&str))
+		require.Equal(t, str, id.String())
+		require.Equal(t, uuid.UUID(id).String(), id.String())
+
+		// Scan from the string representation.
+		val = UUID{}
+		require.NoError(t, db.QueryRow(`SELECT uuid::VARCHAR FROM uuid_test WHERE uuid = ?`, id).Scan(&val))
+		require.Equal(t, id, val)
+	})
+
+	t.Run("UUID Scanner", func(t *testing.T) {
+		var val UUID
+		require.NoError(t, val.Scan("80000000-0000-0000-0000-200000000000"))
+		require.Equal(t, "80000000-0000-0000-0000-200000000000", val.String())
+		require.NoError(t, val.Scan("8000000000000000000000000000000A"))
+		require.Equal(t, "80000000-0000-0000-0000-00000000000a", val.String())
+
+		require.ErrorContains(t, val.Scan("80000000-0000"), invalidInputErrMsg)
+		require.ErrorContains(t, val.Scan("x0000000-0000-0000-0000-200000000000"), invalidInputErrMsg)
+		require.ErrorContains(t, val.Scan(nil), castErrMsg)
+		require.ErrorContains(t, val.Scan(42), castErrMsg)
+	})
+
+	require.NoError(t, db.Close())
+}
+
+func TestNullTypes(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	_, err := db.Exec(`CREATE TABLE nulls (d DECIMAL(9, 3), i INTERVAL, u UUID)`)
+	require.NoError(t, err)
+
+	valid := []any{
+		NullDecimal{Decimal: Decimal{Width: 9, Scale: 3, Value: big.NewInt(-12345)}, Valid: true},
+		NullInterval{Interval: Interval{Days: 1, Months: 2, Micros: 3}, Valid: true},
+		NullUUID{UUID: UUID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true},
+	}
+	_, err = db.Exec(`INSERT INTO nulls VALUES (?, ?, ?)`, valid...)
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO nulls VALUES (?, ?, ?)`, NullDecimal{}, NullInterval{}, NullUUID{})
+	require.NoError(t, err)
+
+	rows, err := db.Query(`SELECT d, i, u FROM nulls ORDER BY d NULLS LAST`)
+	require.NoError(t, err)
+
+	var res [][]any
+	for rows.Next() {
+		// Scanning NULL resets previously scanned values.
+		d := NullDecimal{Valid: true}
+		i := NullInterval{Valid: true}
+		u := NullUUID{Valid: true}
+		require.NoError(t, rows.Scan(&d, &i, &u))
+		res = append(res, []any{d, i, u})
+	}
+	require.NoError(t, rows.Err())
+	require.NoError(t, rows.Close())
+	require.Equal(t, [][]any{valid, {NullDecimal{}, NullInterval{}, NullUUID{}}}, res)
+
+	// The Valuers return DuckDB's string representations.
+	v, err := valid[0].(NullDecimal).Value()
+	require.NoError(t, err)
+	require.Equal(t, "-12.345", v)
+	v, err = valid[1].(NullInterval).Value()
+	require.NoError(t, err)
+	require.Equal(t, "2 months 1 day 00:00:00.000003", v)
+	v, err = valid[2].(NullUUID).Value()
+	require.NoError(t, err)
+	require.Equal(t, "01020304-0506-0708-090a-0b0c0d0e0f10", v)
+	v, err = NullDecimal{}.Value()
+	require.NoError(t, err)
+	require.Nil(t, v)
+
 	require.NoError(t, db.Close())
 }
 
@@ -659,6 +1465,11 @@ func TestDate(t *testing.T) {
 		"epoch":       {input: "1970-01-01", want: time.UnixMilli(0).UTC()},
 		"before 1970": {input: "1950-12-12", want: time.Date(1950, time.December, 12, 0, 0, 0, 0, time.UTC)},
 		"after 1970":  {input: "2022-12-12", want: time.Date(2022, time.December, 12, 0, 0, 0, 0, time.UTC)},
+		"first year":  {input: "0001-01-01", want: time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		"leap day":    {input: "2000-02-29", want: time.Date(2000, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		"max":         {input: "5877642-06-25", want: time.Date(5877642, time.June, 25, 0, 0, 0, 0, time.UTC)},
+		"infinity":    {input: "infinity", want: time.Date(5881580, time.July, 11, 0, 0, 0, 0, time.UTC)},
+		"-infinity":   {input: "-infinity", want: time.Date(-5877641, time.June, 24, 0, 0, 0, 0, time.UTC)},
 	}
 	for _, test := range tests {
 		var res time.Time
@@ -709,6 +1520,79 @@ func TestENUMs(t *testing.T) {
 	require.NoError(t, db.Close())
 }
 
+func TestUnion(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+
+	_, err := db.Exec(`CREATE TABLE unions (u UNION(num INTEGER, str VARCHAR))`)
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO unions VALUES (42), ('hello'), (NULL)`)
+	require.NoError(t, err)
+
+	res, err := db.Query(`SELECT u FROM unions ORDER BY rowid`)
+	require.NoError(t, err)
+
+	var got []*Union
+	for res.Next() {
+		var u *Union
+		require.NoError(t, res.Scan(&u))
+		got = append(got, u)
+	}
+	require.NoError(t, res.Close())
+
+	require.Len(t, got, 3)
+	require.Equal(t, Union{Tag: "num", Value: int32(42)}, *got[0])
+	require.Equal(t, Union{Tag: "str", Value: "hello"}, *got[1])
+	require.Nil(t, got[2])
+
+	// Scan a UNION nested in a LIST.
+	var list Composite[[]Union]
+	err = db.QueryRow(`SELECT [42::UNION(num INTEGER, str VARCHAR), 'hello']`).Scan(&list)
+	require.NoError(t, err)
+	require.Equal(t, []Union{{Tag: "num", Value: int32(42)}, {Tag: "str", Value: "hello"}}, list.Get())
+
+	require.NoError(t, db.Close())
+}
+
+func TestIntegerWidths(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+
+	// Each integer type scans into the Go integer type of the same width.
+	var i8, i16, i32, i64 any
+	var u8, u16, u32, u64 any
+	err := db.QueryRow(`SELECT (-8)::TINYINT, (-16)::SMALLINT, (-32)::INTEGER, (-64)::BIGINT,
+		8::UTINYINT, 16::USMALLINT, 32::UINTEGER, 64::UBIGINT`).Scan(&i8, &i16, &i32, &i64, &u8, &u16, &u32, &u64)
+	require.NoError(t, err)
+	require.Equal(t, []any{int8(-8), int16(-16), int32(-32), int64(-64)}, []any{i8, i16, i32, i64})
+	require.Equal(t, []any{uint8(8), uint16(16), uint32(32), uint64(64)}, []any{u8, u16, u32, u64})
+
+	var si8 int8
+	var su8 uint8
+	var si16 int16
+	var su32 uint32
+	err = db.QueryRow(`SELECT (-128)::TINYINT, 255::UTINYINT, 32767::SMALLINT, 4294967295::UINTEGER`).Scan(&si8, &su8, &si16, &su32)
+	require.NoError(t, err)
+	require.Equal(t, int8(math.MinInt8), si8)
+	require.Equal(t, uint8(math.MaxUint8), su8)
+	require.Equal(t, int16(math.MaxInt16), si16)
+	require.Equal(t, uint32(math.MaxUint32), su32)
+
+	// Wider values fit, if they are in range.
+	require.NoError(t, db.QueryRow(`SELECT 42::BIGINT`).Scan(&si8))
+	require.Equal(t, int8(42), si8)
+
+	// Out-of-range values return an error.
+	require.ErrorContains(t, db.QueryRow(`SELECT 300::INTEGER`).Scan(&si8), "out of range")
+	require.Error(t, db.QueryRow(`SELECT (-1)::INTEGER`).Scan(&su8))
+	var l List[int8]
+	require.NoError(t, db.QueryRow(`SELECT [1::TINYINT, (-1)::TINYINT]`).Scan(&l))
+	require.Equal(t, []int8{1, -1}, l.Get())
+	require.ErrorContains(t, db.QueryRow(`SELECT [300]`).Scan(&l), "cannot cast int32(300) to int8")
+
+	require.NoError(t, db.Close())
+}
+
 func TestHugeInt(t *testing.T) {
 	t.Parallel()
 	db := openDB(t)
@@ -752,7 +1636,150 @@ func TestHugeInt(t *testing.T) {
 		require.Contains(t, err.Error(), "too big for HUGEINT")
 	})
 
+	t.Run("SELECT different UHUGEINT values", func(t *testing.T) {
+		tests := []string{
+			"0",
+			"1",
+			"18446744073709551615",
+			"18446744073709551616",
+			"340282366920938463463374607431768211455",
+		}
+		for _, test := range tests {
+			var res *big.Int
+			err := db.QueryRow(fmt.Sprintf("SELECT '%s'::UHUGEINT", test)).Scan(&res)
+			require.NoError(t, err)
+			require.Equal(t, test, res.String())
+		}
+	})
+
+	t.Run("UHUGEINT binding", func(t *testing.T) {
+		_, err := db.Exec("CREATE TABLE uhugeint_test (number UHUGEINT)")
+		require.NoError(t, err)
+
+		// The maximum UHUGEINT value exceeds the HUGEINT range.
+		val := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+		_, err = db.Exec("INSERT INTO uhugeint_test VALUES(?)", val)
+		require.NoError(t, err)
+
+		var res *big.Int
+		err = db.QueryRow("SELECT number FROM uhugeint_test WHERE number = ?", val).Scan(&res)
+		require.NoError(t, err)
+		require.Equal(t, val.String(), res.String())
+	})
+
+	t.Run("append HUGEINT and UHUGEINT", func(t *testing.T) {
+		c, err := NewConnector("", nil)
+		require.NoError(t, err)
+		conn, err := c.Connect(context.Background())
+		require.NoError(t, err)
+
+		_, err = conn.(*Conn).ExecContext(context.Background(), `CREATE TABLE test (h HUGEINT, u UHUGEINT)`, nil)
+		require.NoError(t, err)
+
+		a, err := NewAppenderFromConn(conn, "", "test")
+		require.NoError(t, err)
+
+		minHuge := new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 127))
+		maxUhuge := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+		require.NoError(t, a.AppendRow(minHuge, maxUhuge))
+		require.NoError(t, a.AppendRow(int8(-1), uint64(math.MaxUint64)))
+		require.NoError(t, a.AppendRow(int32(math.MinInt32), int64(42)))
+
+		err = a.AppendRow(big.NewInt(0), big.NewInt(-1))
+		require.ErrorContains(t, err, "negative")
+		tooBig := new(big.Int).Lsh(big.NewInt(1), 128)
+		err = a.AppendRow(big.NewInt(0), tooBig)
+		require.ErrorContains(t, err, "too big for UHUGEINT")
+		require.NoError(t, a.Close())
+
+		db := sql.OpenDB(c)
+		rows, err := db.Query(`SELECT h::VARCHAR, u::VARCHAR FROM test ORDER BY rowid`)
+		require.NoError(t, err)
+		var actual [][2]string
+		for rows.Next() {
+			var h, u string
+			require.NoError(t, rows.Scan(&h, &u))
+			actual = append(actual, [2]string{h, u})
+		}
+		require.NoError(t, rows.Err())
+		require.NoError(t, rows.Close())
+		require.Equal(t, [][2]string{
+			{"-170141183460469231731687303715884105728", "340282366920938463463374607431768211455"},
+			{"-1", "18446744073709551615"},
+			{"-2147483648", "42"},
+		}, actual)
+
+		require.NoError(t, db.Close())
+		require.NoError(t, conn.Close())
+		require.NoError(t, c.Close())
+	})
+
+	require.NoError(t, db.Close())
+}
+
+func TestVarint(t *testing.T) {
+	t.Parallel()
+
+	// The values exceed the HUGEINT and UHUGEINT ranges.
+	huge, ok := new(big.Int).SetString("123456789012345678901234567890123456789012345678901234567890", 10)
+	require.True(t, ok)
+	values := []*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(-1), big.NewInt(-256), huge, new(big.Int).Neg(huge)}
+
+	c, con, a := prepareAppender(t, `CREATE TABLE test (id INTEGER, v VARINT, l VARINT[])`)
+	for i, v := range values {
+		require.NoError(t, a.AppendRow(int32(i), v, []*big.Int{v, nil}))
+	}
+	require.NoError(t, a.AppendRow(int32(len(values)), nil, nil))
+	require.NoError(t, a.AppendRow(int32(len(values)+1), int64(-42), []any{uint8(7)}))
+	require.NoError(t, a.Flush())
+
+	db := sql.OpenDB(c)
+	res, err := db.Query(`SELECT v, v::VARCHAR, l FROM test ORDER BY id`)
+	require.NoError(t, err)
+	i := 0
+	for ; res.Next(); i++ {
+		var v *big.Int
+		var s *string
+		var l any
+		require.NoError(t, res.Scan(&v, &s, &l))
+		switch {
+		case i < len(values):
+			require.Equal(t, values[i].String(), v.String())
+			require.Equal(t, values[i].String(), *s)
+			require.Len(t, l, 2)
+			require.Equal(t, values[i].String(), l.([]any)[0].(*big.Int).String())
+			require.Nil(t, l.([]any)[1])
+		case i == len(values):
+			require.Nil(t, v)
+			require.Nil(t, l)
+		default:
+			require.Equal(t, "-42", v.String())
+			require.Len(t, l, 1)
+			require.Equal(t, "7", l.([]any)[0].(*big.Int).String())
+		}
+	}
+	require.NoError(t, res.Err())
+	require.NoError(t, res.Close())
+	require.Equal(t, len(values)+2, i)
+
+	// VARINT parameters bind a *big.Int of any size.
+	_, err = db.Exec(`INSERT INTO test (id, v) VALUES (100, ?)`, huge)
+	require.NoError(t, err)
+	var v *big.Int
+	require.NoError(t, db.QueryRow(`SELECT v FROM test WHERE v = ?::VARINT AND id = 100`, huge).Scan(&v))
+	require.Equal(t, huge.String(), v.String())
+
+	cols, err := db.Query(`SELECT v FROM test LIMIT 1`)
+	require.NoError(t, err)
+	types, err := cols.ColumnTypes()
+	require.NoError(t, err)
+	require.Equal(t, reflect.TypeOf(big.NewInt(0)), types[0].ScanType())
+	require.Equal(t, "VARINT", types[0].DatabaseTypeName())
+	require.NoError(t, cols.Close())
+
+	require.ErrorContains(t, a.AppendRow(int32(0), "42", nil), castErrMsg)
 	require.NoError(t, db.Close())
+	cleanupAppender(t, c, con, a)
 }
 
 func TestTimestampTZ(t *testing.T) {
@@ -798,6 +1825,52 @@ func TestBoolean(t *testing.T) {
 	require.NoError(t, db.Close())
 }
 
+func TestNullableBoolean(t *testing.T) {
+	t.Parallel()
+	c, con, a := prepareAppender(t, `CREATE TABLE test (id INTEGER, b BOOLEAN)`)
+
+	yes, no := true, false
+	values := []driver.Value{
+		true, false, nil,
+		&yes, &no, (*bool)(nil),
+		sql.NullBool{Bool: true, Valid: true}, sql.NullBool{Bool: false, Valid: true}, sql.NullBool{},
+	}
+	for i, v := range values {
+		require.NoError(t, a.AppendRow(int32(i), v))
+	}
+	require.NoError(t, a.Flush())
+
+	rows, err := sql.OpenDB(c).Query(`SELECT b, b, b AND NULL, b OR NULL FROM test ORDER BY id`)
+	require.NoError(t, err)
+
+	expected := []sql.NullBool{{Bool: true, Valid: true}, {Bool: false, Valid: true}, {}}
+	i := 0
+	for rows.Next() {
+		var ptr *bool
+		var n, and, or sql.NullBool
+		require.NoError(t, rows.Scan(&ptr, &n, &and, &or))
+
+		// The rows repeat true, false, and NULL for each appended form.
+		e := expected[i%3]
+		require.Equal(t, e, n)
+		if e.Valid {
+			require.NotNil(t, ptr)
+			require.Equal(t, e.Bool, *ptr)
+		} else {
+			require.Nil(t, ptr)
+		}
+
+		// Three-valued logic: false AND NULL is false, true OR NULL is true, otherwise NULL.
+		require.Equal(t, sql.NullBool{Bool: false, Valid: e.Valid && !e.Bool}, and)
+		require.Equal(t, sql.NullBool{Bool: e.Valid && e.Bool, Valid: e.Valid && e.Bool}, or)
+		i++
+	}
+	require.NoError(t, rows.Err())
+	require.Equal(t, len(values), i)
+	require.NoError(t, rows.Close())
+	cleanupAppender(t, c, con, a)
+}
+
 func TestTimestamp(t *testing.T) {
 	t.Parallel()
 	db := openDB(t)
@@ -860,6 +1933,73 @@ func TestInterval(t *testing.T) {
 		}
 	})
 
+	t.Run("INTERVAL string representation", func(t *testing.T) {
+		tests := []string{
+			"INTERVAL 1 YEAR",
+			"INTERVAL 14 MONTH",
+			"INTERVAL 1 DAY",
+			"INTERVAL 90 MINUTE",
+			"INTERVAL 0 SECOND",
+			"-INTERVAL 14 MONTH",
+			"-INTERVAL 3 DAY",
+			"-INTERVAL 1 MICROSECOND",
+			"INTERVAL 2 YEAR + INTERVAL 1 DAY + INTERVAL 123456789 MICROSECOND",
+			"INTERVAL 1 MONTH - INTERVAL 1 DAY - INTERVAL 100 HOUR",
+		}
+		for _, test := range tests {
+			var res Interval
+			var str string
+			require.NoError(t, db.QueryRow(fmt.Sprintf("SELECT %s, (%s)::VARCHAR", test, test)).Scan(&res, &str))
+			require.Equal(t, str, res.String(), test)
+
+			// The string parses into the same interval.
+			var parsed Interval
+			require.NoError(t, db.QueryRow(`SELECT ?::VARCHAR::INTERVAL`, res.String()).Scan(&parsed))
+			require.Equal(t, res, parsed, test)
+		}
+	})
+
+	t.Run("INTERVAL Scanner and Valuer", func(t *testing.T) {
+		var res Interval
+		require.NoError(t, db.QueryRow(`SELECT INTERVAL 1 YEAR`).Scan(&res))
+		require.Equal(t, Interval{Months: 12}, res)
+		require.ErrorContains(t, res.Scan("1 year"), castErrMsg)
+
+		v, err := Interval{Months: 12, Days: 1}.Value()
+		require.NoError(t, err)
+		require.Equal(t, "1 year 1 day", v)
+	})
+
+	require.NoError(t, db.Close())
+}
+
+func TestGeometry(t *testing.T) {
+	t.Parallel()
+	db := openDB(t)
+	if _, err := db.Exec(`INSTALL spatial; LOAD spatial`); err != nil {
+		t.Skip("the spatial extension is not available: ", err)
+	}
+
+	res, err := db.Query(`SELECT ST_Point(1, 2) AS g, ST_AsWKB(ST_Point(1, 2)) AS wkb, [ST_Point(1, 2)] AS l`)
+	require.NoError(t, err)
+	cols, err := res.ColumnTypes()
+	require.NoError(t, err)
+	require.Equal(t, "GEOMETRY", cols[0].DatabaseTypeName())
+	require.Equal(t, reflect.TypeOf([]byte{}), cols[0].ScanType())
+
+	require.True(t, res.Next())
+	var g, wkb []byte
+	var l any
+	require.NoError(t, res.Scan(&g, &wkb, &l))
+	require.NotEmpty(t, g)
+	require.Equal(t, []any{g}, l)
+
+	// A little-endian WKB point.
+	expected := []byte{1, 1, 0, 0, 0}
+	expected = binary.LittleEndian.AppendUint64(expected, math.Float64bits(1))
+	expected = binary.LittleEndian.AppendUint64(expected, math.Float64bits(2))
+	require.Equal(t, expected, wkb)
+	require.NoError(t, res.Close())
 	require.NoError(t, db.Close())
 }
 
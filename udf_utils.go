@@ -45,11 +45,29 @@ func setFuncError(function_info C.duckdb_function_info, msg string) {
 	C.duckdb_scalar_function_set_error(function_info, err)
 }
 
+func setTableFuncError(function_info C.duckdb_function_info, msg string) {
+	err := C.CString(msg)
+	defer C.duckdb_free(unsafe.Pointer(err))
+	C.duckdb_function_set_error(function_info, err)
+}
+
+func setAggregateFuncError(function_info C.duckdb_function_info, msg string) {
+	err := C.CString(msg)
+	defer C.duckdb_free(unsafe.Pointer(err))
+	C.duckdb_aggregate_function_set_error(function_info, err)
+}
+
 // Data deletion handlers.
 
 //export udf_delete_callback
 func udf_delete_callback(info unsafe.Pointer) {
 	h := (*cgo.Handle)(info)
+	// Close the iterator of a table function, if DuckDB did not request all of its rows.
+	if data, ok := h.Value().(pinnedValue[tableFunctionData]); ok {
+		if source, isIter := data.value.fun.(*rowIteratorSource); isIter {
+			source.close()
+		}
+	}
 	h.Value().(unpinner).unpin()
 	h.Delete()
 }
@@ -6,6 +6,10 @@ package duckdb
 import "C"
 
 import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"time"
 	"unsafe"
 )
 
@@ -55,6 +59,9 @@ func getValue(info TypeInfo, v C.duckdb_value) (any, error) {
 	case TYPE_HUGEINT:
 		hugeint := C.duckdb_get_hugeint(v)
 		return hugeIntToNative(hugeint), nil
+	case TYPE_UHUGEINT:
+		uhugeint := C.duckdb_get_uhugeint(v)
+		return uhugeIntToNative(uhugeint), nil
 	case TYPE_VARCHAR:
 		str := C.duckdb_get_varchar(v)
 		ret := C.GoString(str)
@@ -64,3 +71,284 @@ func getValue(info TypeInfo, v C.duckdb_value) (any, error) {
 		return nil, unsupportedTypeError(typeToStringMap[t])
 	}
 }
+
+var (
+	reflectTypeTime     = reflect.TypeOf(time.Time{})
+	reflectTypeInterval = reflect.TypeOf(Interval{})
+	reflectTypeBigInt   = reflect.TypeOf((*big.Int)(nil))
+	reflectTypeBytes    = reflect.TypeOf([]byte{})
+	reflectTypeUUID     = reflect.TypeOf(UUID{})
+)
+
+// valueTypeInfo returns the TypeInfo of the DuckDB values created from the Go type t by createValue.
+// E.g., a []int32 creates INTEGER[] values, and a [2][]string creates VARCHAR[][2] values.
+func valueTypeInfo(t reflect.Type) (TypeInfo, error) {
+	switch t {
+	case reflectTypeTime:
+		return NewTypeInfo(TYPE_TIMESTAMP)
+	case reflectTypeInterval:
+		return NewTypeInfo(TYPE_INTERVAL)
+	case reflectTypeBigInt:
+		return NewTypeInfo(TYPE_HUGEINT)
+	case reflectTypeBytes:
+		return NewTypeInfo(TYPE_BLOB)
+	case reflectTypeUUID:
+		return NewTypeInfo(TYPE_UUID)
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return NewTypeInfo(TYPE_BOOLEAN)
+	case reflect.Int8:
+		return NewTypeInfo(TYPE_TINYINT)
+	case reflect.Int16:
+		return NewTypeInfo(TYPE_SMALLINT)
+	case reflect.Int32:
+		return NewTypeInfo(TYPE_INTEGER)
+	case reflect.Int, reflect.Int64:
+		return NewTypeInfo(TYPE_BIGINT)
+	case reflect.Uint8:
+		return NewTypeInfo(TYPE_UTINYINT)
+	case reflect.Uint16:
+		return NewTypeInfo(TYPE_USMALLINT)
+	case reflect.Uint32:
+		return NewTypeInfo(TYPE_UINTEGER)
+	case reflect.Uint, reflect.Uint64:
+		return NewTypeInfo(TYPE_UBIGINT)
+	case reflect.Float32:
+		return NewTypeInfo(TYPE_FLOAT)
+	case reflect.Float64:
+		return NewTypeInfo(TYPE_DOUBLE)
+	case reflect.String:
+		return NewTypeInfo(TYPE_VARCHAR)
+	case reflect.Slice:
+		child, err := valueTypeInfo(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return NewListInfo(child)
+	case reflect.Array:
+		child, err := valueTypeInfo(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return NewArrayInfo(child, uint64(t.Len()))
+	case reflect.Struct:
+		return structValueTypeInfo(t)
+	case reflect.Map:
+		return mapEntriesTypeInfo(t)
+	}
+	return nil, unsupportedTypeError(t.String())
+}
+
+// structValueTypeInfo returns the STRUCT type of a Go struct. Like the appender, it maps each exported
+// Go struct field to a STRUCT field with the same name, and a `db:"name"` tag overrides a field's name.
+func structValueTypeInfo(t reflect.Type) (TypeInfo, error) {
+	var entries []StructEntry
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := field.Name
+		if tag, ok := field.Tag.Lookup("db"); ok {
+			name = tag
+		}
+
+		info, err := valueTypeInfo(field.Type)
+		if err != nil {
+			return nil, fmt.Errorf("%w: field: %s", err, name)
+		}
+		entry, err := NewStructEntry(info, name)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	if len(entries) == 0 {
+		return nil, unsupportedTypeError(t.String())
+	}
+	return NewStructInfo(entries[0], entries[1:]...)
+}
+
+// mapEntriesTypeInfo returns the type of the key-value entries of a Go map, i.e., STRUCT(key K, value V)[].
+// The C API cannot create MAP values, so Go maps create LIST values of their entries instead, see mapValue.
+func mapEntriesTypeInfo(t reflect.Type) (TypeInfo, error) {
+	keyInfo, err := valueTypeInfo(t.Key())
+	if err != nil {
+		return nil, err
+	}
+	valueInfo, err := valueTypeInfo(t.Elem())
+	if err != nil {
+		return nil, err
+	}
+
+	keyEntry, err := NewStructEntry(keyInfo, mapKeysField())
+	if err != nil {
+		return nil, err
+	}
+	valueEntry, err := NewStructEntry(valueInfo, mapValuesField())
+	if err != nil {
+		return nil, err
+	}
+	entryInfo, err := NewStructInfo(keyEntry, valueEntry)
+	if err != nil {
+		return nil, err
+	}
+	return NewListInfo(entryInfo)
+}
+
+// createValue creates a DuckDB value of type info from v, whose type must match info, see valueTypeInfo.
+// The caller must destroy the value with duckdb_destroy_value.
+func createValue(info TypeInfo, v reflect.Value) (C.duckdb_value, error) {
+	switch t := info.InternalType(); t {
+	case TYPE_BOOLEAN:
+		return C.duckdb_create_bool(C.bool(v.Bool())), nil
+	case TYPE_TINYINT:
+		return C.duckdb_create_int8(C.int8_t(v.Int())), nil
+	case TYPE_SMALLINT:
+		return C.duckdb_create_int16(C.int16_t(v.Int())), nil
+	case TYPE_INTEGER:
+		return C.duckdb_create_int32(C.int32_t(v.Int())), nil
+	case TYPE_BIGINT:
+		return C.duckdb_create_int64(C.int64_t(v.Int())), nil
+	case TYPE_UTINYINT:
+		return C.duckdb_create_uint8(C.uint8_t(v.Uint())), nil
+	case TYPE_USMALLINT:
+		return C.duckdb_create_uint16(C.uint16_t(v.Uint())), nil
+	case TYPE_UINTEGER:
+		return C.duckdb_create_uint32(C.uint32_t(v.Uint())), nil
+	case TYPE_UBIGINT:
+		return C.duckdb_create_uint64(C.uint64_t(v.Uint())), nil
+	case TYPE_FLOAT:
+		return C.duckdb_create_float(C.float(v.Float())), nil
+	case TYPE_DOUBLE:
+		return C.duckdb_create_double(C.double(v.Float())), nil
+	case TYPE_VARCHAR:
+		str := C.CString(v.String())
+		defer C.duckdb_free(unsafe.Pointer(str))
+		return C.duckdb_create_varchar_length(str, C.idx_t(v.Len())), nil
+	case TYPE_UUID:
+		// The C API cannot create UUID values, so it casts the string representation.
+		str := C.CString(v.Interface().(UUID).String())
+		defer C.duckdb_free(unsafe.Pointer(str))
+		return C.duckdb_create_varchar(str), nil
+	case TYPE_BLOB:
+		b := v.Bytes()
+		if len(b) == 0 {
+			return C.duckdb_create_blob(nil, 0), nil
+		}
+		return C.duckdb_create_blob((*C.uint8_t)(unsafe.Pointer(&b[0])), C.idx_t(len(b))), nil
+	case TYPE_TIMESTAMP:
+		ts := C.duckdb_timestamp{micros: C.int64_t(v.Interface().(time.Time).UTC().UnixMicro())}
+		return C.duckdb_create_timestamp(ts), nil
+	case TYPE_INTERVAL:
+		i := v.Interface().(Interval)
+		interval := C.duckdb_interval{months: C.int32_t(i.Months), days: C.int32_t(i.Days), micros: C.int64_t(i.Micros)}
+		return C.duckdb_create_interval(interval), nil
+	case TYPE_HUGEINT:
+		// The C API cannot create NULL values.
+		if v.IsNil() {
+			return nil, unsupportedTypeError(v.Type().String())
+		}
+		val, err := hugeIntFromNative(v.Interface().(*big.Int))
+		if err != nil {
+			return nil, err
+		}
+		return C.duckdb_create_hugeint(val), nil
+	case TYPE_LIST, TYPE_ARRAY:
+		if v.Kind() == reflect.Map {
+			return createMapEntriesValue(info, v)
+		}
+		return createNestedValue(info, v)
+	case TYPE_STRUCT:
+		return createStructValue(info, v)
+	default:
+		return nil, unsupportedTypeError(typeToStringMap[t])
+	}
+}
+
+func createNestedValue(info TypeInfo, v reflect.Value) (C.duckdb_value, error) {
+	details := info.(*typeInfo)
+	n := v.Len()
+
+	// The C API requires a non-NULL values pointer, even for empty values.
+	values := make([]C.duckdb_value, max(n, 1))
+	defer func() {
+		for i := range values {
+			C.duckdb_destroy_value(&values[i])
+		}
+	}()
+
+	for i := 0; i < n; i++ {
+		val, err := createValue(details.childTypes[0], v.Index(i))
+		if err != nil {
+			return nil, addIndexToError(err, i)
+		}
+		values[i] = val
+	}
+
+	childType := details.childTypes[0].logicalType()
+	defer C.duckdb_destroy_logical_type(&childType)
+
+	var val C.duckdb_value
+	if info.InternalType() == TYPE_ARRAY {
+		val = C.duckdb_create_array_value(childType, &values[0], C.idx_t(n))
+	} else {
+		val = C.duckdb_create_list_value(childType, &values[0], C.idx_t(n))
+	}
+	if val == nil {
+		return nil, unsupportedTypeError(info.String())
+	}
+	return val, nil
+}
+
+func createMapEntriesValue(info TypeInfo, v reflect.Value) (C.duckdb_value, error) {
+	// Convert the map to a slice of its entries, which have the Go type of the STRUCT(key K, value V) entries.
+	entryType := reflect.StructOf([]reflect.StructField{
+		{Name: "Key", Type: v.Type().Key(), Tag: `db:"key"`},
+		{Name: "Value", Type: v.Type().Elem(), Tag: `db:"value"`},
+	})
+	entries := reflect.MakeSlice(reflect.SliceOf(entryType), 0, v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		entry := reflect.New(entryType).Elem()
+		entry.Field(0).Set(iter.Key())
+		entry.Field(1).Set(iter.Value())
+		entries = reflect.Append(entries, entry)
+	}
+	return createNestedValue(info, entries)
+}
+
+func createStructValue(info TypeInfo, v reflect.Value) (C.duckdb_value, error) {
+	m, err := structToMap(v)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := info.(*typeInfo).structEntries
+	values := make([]C.duckdb_value, len(entries))
+	defer func() {
+		for i := range values {
+			C.duckdb_destroy_value(&values[i])
+		}
+	}()
+
+	for i, entry := range entries {
+		val, err := createValue(entry.Info(), reflect.ValueOf(m[entry.Name()]))
+		if err != nil {
+			return nil, fmt.Errorf("%w: field: %s", err, entry.Name())
+		}
+		values[i] = val
+	}
+
+	logicalType := info.(*typeInfo).logicalType()
+	defer C.duckdb_destroy_logical_type(&logicalType)
+
+	val := C.duckdb_create_struct_value(logicalType, &values[0])
+	if val == nil {
+		return nil, unsupportedTypeError(info.String())
+	}
+	return val, nil
+}
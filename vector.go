@@ -7,6 +7,7 @@ import "C"
 
 import (
 	"reflect"
+	"time"
 	"unsafe"
 )
 
@@ -24,6 +25,10 @@ type vector struct {
 	setFn fnSetVectorValue
 	// The child vectors of nested data types.
 	childVectors []vector
+	// rawJSON is true, if JSON values return json.RawMessage values instead of unmarshalled values.
+	rawJSON bool
+	// location is the location of TIMESTAMPTZ values, or nil for UTC.
+	location *time.Location
 
 	// The vector's type information.
 	vectorTypeInfo
@@ -39,6 +44,22 @@ func (*vector) canNil(val reflect.Value) bool {
 	}
 }
 
+// isNull returns true, if the value is nil or a typed nil pointer, which both write NULL.
+func isNull(val any) bool {
+	if val == nil {
+		return true
+	}
+	rv := reflect.ValueOf(val)
+	return rv.Kind() == reflect.Pointer && rv.IsNil()
+}
+
+// isNilSlice returns true, if the value is a nil slice, which writes a NULL LIST or ARRAY,
+// like the nil slice elements of a LIST.
+func isNilSlice(val any) bool {
+	rv := reflect.ValueOf(val)
+	return rv.Kind() == reflect.Slice && rv.IsNil()
+}
+
 func (vec *vector) init(logicalType C.duckdb_logical_type, colIdx int) error {
 	t := Type(C.duckdb_get_type_id(logicalType))
 	name, inMap := unsupportedTypeToStringMap[t]
@@ -88,6 +109,8 @@ func (vec *vector) init(logicalType C.duckdb_logical_type, colIdx int) error {
 		vec.initInterval()
 	case TYPE_HUGEINT:
 		vec.initHugeint()
+	case TYPE_UHUGEINT:
+		vec.initUhugeint()
 	case TYPE_VARCHAR, TYPE_BLOB:
 		vec.initBytes(t)
 	case TYPE_DECIMAL:
@@ -102,8 +125,14 @@ func (vec *vector) init(logicalType C.duckdb_logical_type, colIdx int) error {
 		return vec.initMap(logicalType, colIdx)
 	case TYPE_ARRAY:
 		return vec.initArray(logicalType, colIdx)
+	case TYPE_UNION:
+		return vec.initUnion(logicalType, colIdx)
 	case TYPE_UUID:
 		vec.initUUID()
+	case TYPE_BIT:
+		vec.initBit()
+	case TYPE_VARINT:
+		vec.initVarint()
 	case TYPE_SQLNULL:
 		vec.initSQLNull()
 	default:
@@ -140,7 +169,7 @@ func (vec *vector) getChildVectors(v C.duckdb_vector, writable bool) {
 	case TYPE_LIST, TYPE_MAP:
 		child := C.duckdb_list_vector_get_child(v)
 		vec.childVectors[0].initVectors(child, writable)
-	case TYPE_STRUCT:
+	case TYPE_STRUCT, TYPE_UNION:
 		for i := 0; i < len(vec.childVectors); i++ {
 			child := C.duckdb_struct_vector_get_child(v, C.idx_t(i))
 			vec.childVectors[i].initVectors(child, writable)
@@ -159,7 +188,7 @@ func initBool(vec *vector) {
 		return getPrimitive[bool](vec, rowIdx)
 	}
 	vec.setFn = func(vec *vector, rowIdx C.idx_t, val any) error {
-		if val == nil {
+		if isNull(val) {
 			vec.setNull(rowIdx)
 			return nil
 		}
@@ -176,7 +205,7 @@ func initNumeric[T numericType](vec *vector, t Type) {
 		return getPrimitive[T](vec, rowIdx)
 	}
 	vec.setFn = func(vec *vector, rowIdx C.idx_t, val any) error {
-		if val == nil {
+		if isNull(val) {
 			vec.setNull(rowIdx)
 			return nil
 		}
@@ -193,7 +222,7 @@ func (vec *vector) initTS(t Type) {
 		return vec.getTS(t, rowIdx)
 	}
 	vec.setFn = func(vec *vector, rowIdx C.idx_t, val any) error {
-		if val == nil {
+		if isNull(val) {
 			vec.setNull(rowIdx)
 			return nil
 		}
@@ -210,7 +239,7 @@ func (vec *vector) initDate() {
 		return vec.getDate(rowIdx)
 	}
 	vec.setFn = func(vec *vector, rowIdx C.idx_t, val any) error {
-		if val == nil {
+		if isNull(val) {
 			vec.setNull(rowIdx)
 			return nil
 		}
@@ -227,7 +256,7 @@ func (vec *vector) initTime(t Type) {
 		return vec.getTime(rowIdx)
 	}
 	vec.setFn = func(vec *vector, rowIdx C.idx_t, val any) error {
-		if val == nil {
+		if isNull(val) {
 			vec.setNull(rowIdx)
 			return nil
 		}
@@ -244,7 +273,7 @@ func (vec *vector) initInterval() {
 		return vec.getInterval(rowIdx)
 	}
 	vec.setFn = func(vec *vector, rowIdx C.idx_t, val any) error {
-		if val == nil {
+		if isNull(val) {
 			vec.setNull(rowIdx)
 			return nil
 		}
@@ -261,7 +290,7 @@ func (vec *vector) initHugeint() {
 		return vec.getHugeint(rowIdx)
 	}
 	vec.setFn = func(vec *vector, rowIdx C.idx_t, val any) error {
-		if val == nil {
+		if isNull(val) {
 			vec.setNull(rowIdx)
 			return nil
 		}
@@ -270,6 +299,23 @@ func (vec *vector) initHugeint() {
 	vec.Type = TYPE_HUGEINT
 }
 
+func (vec *vector) initUhugeint() {
+	vec.getFn = func(vec *vector, rowIdx C.idx_t) any {
+		if vec.getNull(rowIdx) {
+			return nil
+		}
+		return vec.getUhugeint(rowIdx)
+	}
+	vec.setFn = func(vec *vector, rowIdx C.idx_t, val any) error {
+		if isNull(val) {
+			vec.setNull(rowIdx)
+			return nil
+		}
+		return setUhugeint(vec, rowIdx, val)
+	}
+	vec.Type = TYPE_UHUGEINT
+}
+
 func (vec *vector) initBytes(t Type) {
 	vec.getFn = func(vec *vector, rowIdx C.idx_t) any {
 		if vec.getNull(rowIdx) {
@@ -278,7 +324,7 @@ func (vec *vector) initBytes(t Type) {
 		return vec.getBytes(rowIdx)
 	}
 	vec.setFn = func(vec *vector, rowIdx C.idx_t, val any) error {
-		if val == nil {
+		if isNull(val) {
 			vec.setNull(rowIdx)
 			return nil
 		}
@@ -287,6 +333,22 @@ func (vec *vector) initBytes(t Type) {
 	vec.Type = t
 }
 
+// setRawJSON makes the JSON values of the vector and its child vectors return json.RawMessage values.
+func (vec *vector) setRawJSON() {
+	vec.rawJSON = true
+	for i := range vec.childVectors {
+		vec.childVectors[i].setRawJSON()
+	}
+}
+
+// setLocation makes the TIMESTAMPTZ values of the vector and its child vectors return times in loc.
+func (vec *vector) setLocation(loc *time.Location) {
+	vec.location = loc
+	for i := range vec.childVectors {
+		vec.childVectors[i].setLocation(loc)
+	}
+}
+
 func (vec *vector) initJSON() {
 	vec.getFn = func(vec *vector, rowIdx C.idx_t) any {
 		if vec.getNull(rowIdx) {
@@ -295,7 +357,7 @@ func (vec *vector) initJSON() {
 		return vec.getJSON(rowIdx)
 	}
 	vec.setFn = func(vec *vector, rowIdx C.idx_t, val any) error {
-		if val == nil {
+		if isNull(val) {
 			vec.setNull(rowIdx)
 			return nil
 		}
@@ -318,7 +380,7 @@ func (vec *vector) initDecimal(logicalType C.duckdb_logical_type, colIdx int) er
 			return vec.getDecimal(rowIdx)
 		}
 		vec.setFn = func(vec *vector, rowIdx C.idx_t, val any) error {
-			if val == nil {
+			if isNull(val) {
 				vec.setNull(rowIdx)
 				return nil
 			}
@@ -337,10 +399,12 @@ func (vec *vector) initEnum(logicalType C.duckdb_logical_type, colIdx int) error
 	// Initialize the dictionary.
 	dictSize := uint32(C.duckdb_enum_dictionary_size(logicalType))
 	vec.dict = make(map[string]uint32)
+	vec.dictNames = make([]string, dictSize)
 	for i := uint32(0); i < dictSize; i++ {
 		cStr := C.duckdb_enum_dictionary_value(logicalType, C.idx_t(i))
 		str := C.GoString(cStr)
 		vec.dict[str] = i
+		vec.dictNames[i] = str
 		C.duckdb_free(unsafe.Pointer(cStr))
 	}
 
@@ -354,7 +418,7 @@ func (vec *vector) initEnum(logicalType C.duckdb_logical_type, colIdx int) error
 			return vec.getEnum(rowIdx)
 		}
 		vec.setFn = func(vec *vector, rowIdx C.idx_t, val any) error {
-			if val == nil {
+			if isNull(val) {
 				vec.setNull(rowIdx)
 				return nil
 			}
@@ -388,7 +452,7 @@ func (vec *vector) initList(logicalType C.duckdb_logical_type, colIdx int) error
 		return vec.getList(rowIdx)
 	}
 	vec.setFn = func(vec *vector, rowIdx C.idx_t, val any) error {
-		if val == nil {
+		if isNull(val) || isNilSlice(val) {
 			vec.setNull(rowIdx)
 			return nil
 		}
@@ -432,7 +496,7 @@ func (vec *vector) initStruct(logicalType C.duckdb_logical_type, colIdx int) err
 		return vec.getStruct(rowIdx)
 	}
 	vec.setFn = func(vec *vector, rowIdx C.idx_t, val any) error {
-		if val == nil {
+		if isNull(val) {
 			vec.setNull(rowIdx)
 			return nil
 		}
@@ -457,13 +521,13 @@ func (vec *vector) initMap(logicalType C.duckdb_logical_type, colIdx int) error
 	}
 
 	// DuckDB supports more MAP key types than Go, which only supports comparable types.
-	// We ensure that the key type itself is comparable.
+	// We ensure that the key type itself is comparable. getMap converts BLOB and UUID keys
+	// to string and UUID keys.
 	keyType := C.duckdb_map_type_key_type(logicalType)
 	defer C.duckdb_destroy_logical_type(&keyType)
 
 	t := Type(C.duckdb_get_type_id(keyType))
-	switch t {
-	case TYPE_LIST, TYPE_STRUCT, TYPE_MAP, TYPE_ARRAY:
+	if !isComparableMapKeyType(t) {
 		return addIndexToError(errUnsupportedMapKeyType, colIdx)
 	}
 
@@ -474,7 +538,7 @@ func (vec *vector) initMap(logicalType C.duckdb_logical_type, colIdx int) error
 		return vec.getMap(rowIdx)
 	}
 	vec.setFn = func(vec *vector, rowIdx C.idx_t, val any) error {
-		if val == nil {
+		if isNull(val) {
 			vec.setNull(rowIdx)
 			return nil
 		}
@@ -505,7 +569,7 @@ func (vec *vector) initArray(logicalType C.duckdb_logical_type, colIdx int) erro
 		return vec.getArray(rowIdx)
 	}
 	vec.setFn = func(vec *vector, rowIdx C.idx_t, val any) error {
-		if val == nil {
+		if isNull(val) || isNilSlice(val) {
 			vec.setNull(rowIdx)
 			return nil
 		}
@@ -515,6 +579,53 @@ func (vec *vector) initArray(logicalType C.duckdb_logical_type, colIdx int) erro
 	return nil
 }
 
+func (vec *vector) initUnion(logicalType C.duckdb_logical_type, colIdx int) error {
+	// A UNION is a STRUCT vector. Its first child holds the tags, and each other child holds a member.
+	memberCount := int(C.duckdb_union_type_member_count(logicalType))
+	vec.childVectors = make([]vector, memberCount+1)
+	initNumeric[uint8](&vec.childVectors[0], TYPE_UTINYINT)
+
+	vec.structEntries = make([]StructEntry, 0, memberCount)
+	vec.dict = make(map[string]uint32)
+
+	// Recurse into the members.
+	for i := 0; i < memberCount; i++ {
+		cName := C.duckdb_union_type_member_name(logicalType, C.idx_t(i))
+		name := C.GoString(cName)
+		C.duckdb_free(unsafe.Pointer(cName))
+
+		entry, err := NewStructEntry(nil, name)
+		if err != nil {
+			return err
+		}
+		vec.structEntries = append(vec.structEntries, entry)
+		vec.dict[name] = uint32(i)
+
+		memberType := C.duckdb_union_type_member_type(logicalType, C.idx_t(i))
+		err = vec.childVectors[i+1].init(memberType, colIdx)
+		C.duckdb_destroy_logical_type(&memberType)
+		if err != nil {
+			return err
+		}
+	}
+
+	vec.getFn = func(vec *vector, rowIdx C.idx_t) any {
+		if vec.getNull(rowIdx) {
+			return nil
+		}
+		return vec.getUnion(rowIdx)
+	}
+	vec.setFn = func(vec *vector, rowIdx C.idx_t, val any) error {
+		if isNull(val) {
+			vec.setNull(rowIdx)
+			return nil
+		}
+		return setUnion(vec, rowIdx, val)
+	}
+	vec.Type = TYPE_UNION
+	return nil
+}
+
 func (vec *vector) initUUID() {
 	vec.getFn = func(vec *vector, rowIdx C.idx_t) any {
 		if vec.getNull(rowIdx) {
@@ -524,7 +635,7 @@ func (vec *vector) initUUID() {
 		return hugeIntToUUID(hugeInt)
 	}
 	vec.setFn = func(vec *vector, rowIdx C.idx_t, val any) error {
-		if val == nil || val == (*UUID)(nil) {
+		if isNull(val) {
 			vec.setNull(rowIdx)
 			return nil
 		}
@@ -533,6 +644,40 @@ func (vec *vector) initUUID() {
 	vec.Type = TYPE_UUID
 }
 
+func (vec *vector) initBit() {
+	vec.getFn = func(vec *vector, rowIdx C.idx_t) any {
+		if vec.getNull(rowIdx) {
+			return nil
+		}
+		return vec.getBit(rowIdx)
+	}
+	vec.setFn = func(vec *vector, rowIdx C.idx_t, val any) error {
+		if isNull(val) {
+			vec.setNull(rowIdx)
+			return nil
+		}
+		return setBit(vec, rowIdx, val)
+	}
+	vec.Type = TYPE_BIT
+}
+
+func (vec *vector) initVarint() {
+	vec.getFn = func(vec *vector, rowIdx C.idx_t) any {
+		if vec.getNull(rowIdx) {
+			return nil
+		}
+		return vec.getVarint(rowIdx)
+	}
+	vec.setFn = func(vec *vector, rowIdx C.idx_t, val any) error {
+		if isNull(val) {
+			vec.setNull(rowIdx)
+			return nil
+		}
+		return setVarint(vec, rowIdx, val)
+	}
+	vec.Type = TYPE_VARINT
+}
+
 func (vec *vector) initSQLNull() {
 	vec.getFn = func(vec *vector, rowIdx C.idx_t) any {
 		return nil
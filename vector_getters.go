@@ -8,6 +8,7 @@ import "C"
 import (
 	"encoding/json"
 	"math/big"
+	"reflect"
 	"time"
 	"unsafe"
 )
@@ -35,7 +36,11 @@ func getPrimitive[T any](vec *vector, rowIdx C.idx_t) T {
 
 func (vec *vector) getTS(t Type, rowIdx C.idx_t) time.Time {
 	val := getPrimitive[C.duckdb_timestamp](vec, rowIdx)
-	return getTS(t, val)
+	ts := getTS(t, val)
+	if t == TYPE_TIMESTAMP_TZ && vec.location != nil {
+		return ts.In(vec.location)
+	}
+	return ts
 }
 
 func getTS(t Type, ts C.duckdb_timestamp) time.Time {
@@ -59,9 +64,9 @@ func (vec *vector) getDate(rowIdx C.idx_t) time.Time {
 	return getDate(date)
 }
 
+// getDate converts the days since the epoch to a time.Time, without calling into the C API.
 func getDate(date C.duckdb_date) time.Time {
-	d := C.duckdb_from_date(date)
-	return time.Date(int(d.year), time.Month(d.month), int(d.day), 0, 0, 0, 0, time.UTC)
+	return time.Unix(int64(date.days)*secondsPerDay, 0).UTC()
 }
 
 func (vec *vector) getTime(rowIdx C.idx_t) time.Time {
@@ -88,8 +93,9 @@ func getTimeTZ(ti C.duckdb_time_tz) time.Time {
 	sec := int(timeTZ.time.sec)
 	// TIMETZ has microsecond precision.
 	nanos := int(timeTZ.time.micros) * 1000
+	// Preserve the UTC offset.
 	loc := time.FixedZone("", int(timeTZ.offset))
-	return time.Date(1, time.January, 1, hour, minute, sec, nanos, loc).UTC()
+	return time.Date(1, time.January, 1, hour, minute, sec, nanos, loc)
 }
 
 func (vec *vector) getInterval(rowIdx C.idx_t) Interval {
@@ -110,26 +116,54 @@ func (vec *vector) getHugeint(rowIdx C.idx_t) *big.Int {
 	return hugeIntToNative(hugeInt)
 }
 
+func (vec *vector) getUhugeint(rowIdx C.idx_t) *big.Int {
+	uhugeInt := getPrimitive[C.duckdb_uhugeint](vec, rowIdx)
+	return uhugeIntToNative(uhugeInt)
+}
+
 func (vec *vector) getBytes(rowIdx C.idx_t) any {
 	cStr := getPrimitive[duckdb_string_t](vec, rowIdx)
 
-	var blob []byte
-	if cStr.length <= stringInlineLength {
-		// Inlined data is stored from byte 4 to stringInlineLength + 4.
-		blob = C.GoBytes(unsafe.Pointer(&cStr.prefix), C.int(cStr.length))
-	} else {
-		// Any strings exceeding stringInlineLength are stored as a pointer in `ptr`.
-		blob = C.GoBytes(unsafe.Pointer(cStr.ptr), C.int(cStr.length))
+	// Inlined data is stored from byte 4 to stringInlineLength + 4.
+	// Any strings exceeding stringInlineLength are stored as a pointer in `ptr`.
+	data := unsafe.Pointer(&cStr.prefix)
+	if cStr.length > stringInlineLength {
+		data = unsafe.Pointer(cStr.ptr)
 	}
 
+	// Copy VARCHAR values directly into a string, to allocate only once.
 	if vec.Type == TYPE_VARCHAR {
-		return string(blob)
+		return C.GoStringN((*C.char)(data), C.int(cStr.length))
+	}
+	return C.GoBytes(data, C.int(cStr.length))
+}
+
+func (vec *vector) getBit(rowIdx C.idx_t) Bit {
+	blob := vec.getBytes(rowIdx).([]byte)
+	return bitFromBlob(blob)
+}
+
+func (vec *vector) getVarint(rowIdx C.idx_t) *big.Int {
+	blob := vec.getBytes(rowIdx).([]byte)
+	return varintFromBlob(blob)
+}
+
+// getRawBytes returns the data of a VARCHAR or BLOB value without copying it.
+// The data references the vector's memory.
+func (vec *vector) getRawBytes(rowIdx C.idx_t) []byte {
+	cStr := &(*[1 << 31]duckdb_string_t)(vec.ptr)[rowIdx]
+	data := unsafe.Pointer(&cStr.prefix)
+	if cStr.length > stringInlineLength {
+		data = unsafe.Pointer(cStr.ptr)
 	}
-	return blob
+	return unsafe.Slice((*byte)(data), int(cStr.length))
 }
 
 func (vec *vector) getJSON(rowIdx C.idx_t) any {
 	bytes := vec.getBytes(rowIdx).(string)
+	if vec.rawJSON {
+		return json.RawMessage(bytes)
+	}
 	var value any
 	_ = json.Unmarshal([]byte(bytes), &value)
 	return value
@@ -170,13 +204,7 @@ func (vec *vector) getEnum(rowIdx C.idx_t) string {
 	case TYPE_UBIGINT:
 		idx = getPrimitive[uint64](vec, rowIdx)
 	}
-
-	logicalType := C.duckdb_vector_get_column_type(vec.duckdbVector)
-	defer C.duckdb_destroy_logical_type(&logicalType)
-
-	val := C.duckdb_enum_dictionary_value(logicalType, (C.idx_t)(idx))
-	defer C.duckdb_free(unsafe.Pointer(val))
-	return C.GoString(val)
+	return vec.dictNames[idx]
 }
 
 func (vec *vector) getList(rowIdx C.idx_t) []any {
@@ -197,16 +225,29 @@ func (vec *vector) getStruct(rowIdx C.idx_t) map[string]any {
 func (vec *vector) getMap(rowIdx C.idx_t) Map {
 	list := vec.getList(rowIdx)
 
+	keyType := vec.childVectors[0].childVectors[0].Type
 	m := Map{}
 	for i := 0; i < len(list); i++ {
 		mapItem := list[i].(map[string]any)
-		key := mapItem[mapKeysField()]
+		key := mapKey(keyType, mapItem[mapKeysField()])
 		val := mapItem[mapValuesField()]
 		m[key] = val
 	}
 	return m
 }
 
+// mapKey converts the byte slices of BLOB and UUID keys to comparable string and UUID keys.
+func mapKey(t Type, key any) any {
+	b, ok := key.([]byte)
+	if !ok {
+		return key
+	}
+	if t == TYPE_UUID {
+		return UUID(b)
+	}
+	return string(b)
+}
+
 func (vec *vector) getArray(rowIdx C.idx_t) []any {
 	length := C.idx_t(vec.arrayLength)
 	return vec.getSliceChild(rowIdx*length, length)
@@ -223,3 +264,80 @@ func (vec *vector) getSliceChild(offset C.idx_t, length C.idx_t) []any {
 	}
 	return slice
 }
+
+func (vec *vector) getUnion(rowIdx C.idx_t) Union {
+	tag := getPrimitive[uint8](&vec.childVectors[0], rowIdx)
+	member := &vec.childVectors[tag+1]
+	return Union{
+		Tag:   vec.structEntries[tag].Name(),
+		Value: member.getFn(member, rowIdx),
+	}
+}
+
+// getColumn returns the first size values of the vector as a slice.
+// Its element type is the scan type of the vector's type, or any, if the type has no scan type.
+// NULL values are the zero value of the element type.
+func (vec *vector) getColumn(size int) (any, error) {
+	switch vec.Type {
+	case TYPE_BOOLEAN:
+		return getPrimitiveColumn[bool](vec, size), nil
+	case TYPE_TINYINT:
+		return getPrimitiveColumn[int8](vec, size), nil
+	case TYPE_SMALLINT:
+		return getPrimitiveColumn[int16](vec, size), nil
+	case TYPE_INTEGER:
+		return getPrimitiveColumn[int32](vec, size), nil
+	case TYPE_BIGINT:
+		return getPrimitiveColumn[int64](vec, size), nil
+	case TYPE_UTINYINT:
+		return getPrimitiveColumn[uint8](vec, size), nil
+	case TYPE_USMALLINT:
+		return getPrimitiveColumn[uint16](vec, size), nil
+	case TYPE_UINTEGER:
+		return getPrimitiveColumn[uint32](vec, size), nil
+	case TYPE_UBIGINT:
+		return getPrimitiveColumn[uint64](vec, size), nil
+	case TYPE_FLOAT:
+		return getPrimitiveColumn[float32](vec, size), nil
+	case TYPE_DOUBLE:
+		return getPrimitiveColumn[float64](vec, size), nil
+	}
+
+	elemType := vec.columnElemType()
+	column := reflect.MakeSlice(reflect.SliceOf(elemType), size, size)
+	for i := 0; i < size; i++ {
+		val := vec.getFn(vec, C.idx_t(i))
+		if val == nil {
+			continue
+		}
+		v := reflect.ValueOf(val)
+		if !v.Type().AssignableTo(elemType) {
+			return nil, castError(v.Type().String(), elemType.String())
+		}
+		column.Index(i).Set(v)
+	}
+	return column.Interface(), nil
+}
+
+// getPrimitiveColumn copies the first size values of a primitive vector into a slice.
+func getPrimitiveColumn[T any](vec *vector, size int) []T {
+	column := make([]T, size)
+	copy(column, unsafe.Slice((*T)(vec.ptr), size))
+
+	// The values of NULL rows are undefined.
+	var zero T
+	for i := 0; i < size; i++ {
+		if vec.getNull(C.idx_t(i)) {
+			column[i] = zero
+		}
+	}
+	return column
+}
+
+// columnElemType returns the element type of the vector's column slices.
+func (vec *vector) columnElemType() reflect.Type {
+	if t := scanType(vec.Type); t != nil {
+		return t
+	}
+	return reflect.TypeOf((*any)(nil)).Elem()
+}
@@ -6,9 +6,14 @@ package duckdb
 import "C"
 
 import (
+	"database/sql"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"math/big"
 	"reflect"
+	"slices"
 	"strconv"
 	"time"
 	"unsafe"
@@ -22,7 +27,7 @@ type fnSetVectorValue func(vec *vector, rowIdx C.idx_t, val any) error
 
 func (vec *vector) setNull(rowIdx C.idx_t) {
 	C.duckdb_validity_set_row_invalid(vec.mask, rowIdx)
-	if vec.Type == TYPE_STRUCT {
+	if vec.Type == TYPE_STRUCT || vec.Type == TYPE_UNION {
 		for i := 0; i < len(vec.childVectors); i++ {
 			vec.childVectors[i].setNull(rowIdx)
 		}
@@ -82,6 +87,18 @@ func setBool[S any](vec *vector, rowIdx C.idx_t, val S) error {
 	switch v := any(val).(type) {
 	case bool:
 		b = v
+	case *bool:
+		if v == nil {
+			vec.setNull(rowIdx)
+			return nil
+		}
+		b = *v
+	case sql.NullBool:
+		if !v.Valid {
+			vec.setNull(rowIdx)
+			return nil
+		}
+		b = v.Bool
 	default:
 		return castError(reflect.TypeOf(val).String(), reflect.TypeOf(b).String())
 	}
@@ -148,24 +165,27 @@ func setTime[S any](vec *vector, rowIdx C.idx_t, val S) error {
 		return castError(reflect.TypeOf(val).String(), reflect.TypeOf(ti).String())
 	}
 
-	// DuckDB stores time as microseconds since 00:00:00.
-	ti = ti.UTC()
-	base := time.Date(1970, time.January, 1, ti.Hour(), ti.Minute(), ti.Second(), ti.Nanosecond(), time.UTC)
-	ticks := base.UnixMicro()
-
 	switch vec.Type {
 	case TYPE_TIME:
 		var duckTime C.duckdb_time
-		duckTime.micros = C.int64_t(ticks)
+		duckTime.micros = C.int64_t(timeToMicros(ti.UTC()))
 		setPrimitive(vec, rowIdx, duckTime)
 	case TYPE_TIME_TZ:
-		// The UTC offset is 0.
-		duckTimeTZ := C.duckdb_create_time_tz(C.int64_t(ticks), 0)
+		// Keep the local time and its UTC offset.
+		_, offset := ti.Zone()
+		duckTimeTZ := C.duckdb_create_time_tz(C.int64_t(timeToMicros(ti)), C.int32_t(offset))
 		setPrimitive(vec, rowIdx, duckTimeTZ)
 	}
 	return nil
 }
 
+// timeToMicros returns the microseconds since 00:00:00 of the time's clock.
+// DuckDB stores time as microseconds since 00:00:00.
+func timeToMicros(ti time.Time) int64 {
+	base := time.Date(1970, time.January, 1, ti.Hour(), ti.Minute(), ti.Second(), ti.Nanosecond(), time.UTC)
+	return base.UnixMicro()
+}
+
 func setInterval[S any](vec *vector, rowIdx C.idx_t, val S) error {
 	var interval Interval
 	switch v := any(val).(type) {
@@ -189,35 +209,27 @@ func setHugeint[S any](vec *vector, rowIdx C.idx_t, val S) error {
 	case uint8:
 		fv = C.duckdb_hugeint{lower: C.uint64_t(v)}
 	case int8:
-		fv = C.duckdb_hugeint{lower: C.uint64_t(v)}
+		fv = hugeIntFromInt64(int64(v))
 	case uint16:
 		fv = C.duckdb_hugeint{lower: C.uint64_t(v)}
 	case int16:
-		fv = C.duckdb_hugeint{lower: C.uint64_t(v)}
+		fv = hugeIntFromInt64(int64(v))
 	case uint32:
 		fv = C.duckdb_hugeint{lower: C.uint64_t(v)}
 	case int32:
-		fv = C.duckdb_hugeint{lower: C.uint64_t(v)}
+		fv = hugeIntFromInt64(int64(v))
 	case uint64:
 		fv = C.duckdb_hugeint{lower: C.uint64_t(v)}
 	case int64:
-		if fv, err = hugeIntFromNative(big.NewInt(v)); err != nil {
-			return err
-		}
+		fv = hugeIntFromInt64(v)
 	case uint:
 		fv = C.duckdb_hugeint{lower: C.uint64_t(v)}
 	case int:
-		if fv, err = hugeIntFromNative(big.NewInt(int64(v))); err != nil {
-			return err
-		}
+		fv = hugeIntFromInt64(int64(v))
 	case float32:
-		if fv, err = hugeIntFromNative(big.NewInt(int64(v))); err != nil {
-			return err
-		}
+		fv = hugeIntFromInt64(int64(v))
 	case float64:
-		if fv, err = hugeIntFromNative(big.NewInt(int64(v))); err != nil {
-			return err
-		}
+		fv = hugeIntFromInt64(int64(v))
 	case *big.Int:
 		if v == nil {
 			return castError(reflect.TypeOf(val).String(), reflect.TypeOf(fv).String())
@@ -239,6 +251,50 @@ func setHugeint[S any](vec *vector, rowIdx C.idx_t, val S) error {
 	return nil
 }
 
+// hugeIntFromInt64 sign-extends v into the upper component.
+func hugeIntFromInt64(v int64) C.duckdb_hugeint {
+	return C.duckdb_hugeint{lower: C.uint64_t(v), upper: C.int64_t(v >> 63)}
+}
+
+func setUhugeint[S any](vec *vector, rowIdx C.idx_t, val S) error {
+	var err error
+	var fv C.duckdb_uhugeint
+	switch v := any(val).(type) {
+	case uint8:
+		fv = C.duckdb_uhugeint{lower: C.uint64_t(v)}
+	case uint16:
+		fv = C.duckdb_uhugeint{lower: C.uint64_t(v)}
+	case uint32:
+		fv = C.duckdb_uhugeint{lower: C.uint64_t(v)}
+	case uint64:
+		fv = C.duckdb_uhugeint{lower: C.uint64_t(v)}
+	case uint:
+		fv = C.duckdb_uhugeint{lower: C.uint64_t(v)}
+	case int8:
+		fv, err = uhugeIntFromNative(big.NewInt(int64(v)))
+	case int16:
+		fv, err = uhugeIntFromNative(big.NewInt(int64(v)))
+	case int32:
+		fv, err = uhugeIntFromNative(big.NewInt(int64(v)))
+	case int64:
+		fv, err = uhugeIntFromNative(big.NewInt(v))
+	case int:
+		fv, err = uhugeIntFromNative(big.NewInt(int64(v)))
+	case *big.Int:
+		if v == nil {
+			return castError(reflect.TypeOf(val).String(), reflect.TypeOf(fv).String())
+		}
+		fv, err = uhugeIntFromNative(v)
+	default:
+		return castError(reflect.TypeOf(val).String(), reflect.TypeOf(fv).String())
+	}
+	if err != nil {
+		return err
+	}
+	setPrimitive(vec, rowIdx, fv)
+	return nil
+}
+
 func setBytes[S any](vec *vector, rowIdx C.idx_t, val S) error {
 	var cStr *C.char
 	var length int
@@ -251,6 +307,8 @@ func setBytes[S any](vec *vector, rowIdx C.idx_t, val S) error {
 		cStr = (*C.char)(C.CBytes(v))
 		defer C.duckdb_free(unsafe.Pointer(cStr))
 		length = len(v)
+	case io.Reader:
+		return setReader(vec, rowIdx, v)
 	default:
 		return castError(reflect.TypeOf(val).String(), reflect.TypeOf(cStr).String())
 	}
@@ -259,7 +317,83 @@ func setBytes[S any](vec *vector, rowIdx C.idx_t, val S) error {
 	return nil
 }
 
+// setReader reads r to its end and sets the data. It closes r, if it is an io.Closer.
+// DuckDB stores each value as a whole, so setReader cannot stream the data into the vector.
+// Instead, it avoids copying the read data into C memory.
+func setReader(vec *vector, rowIdx C.idx_t, r io.Reader) error {
+	b, err := io.ReadAll(r)
+	if closer, ok := r.(io.Closer); ok {
+		err = errors.Join(err, closer.Close())
+	}
+	if err != nil {
+		return err
+	}
+
+	// DuckDB copies the data, and does not keep the pointer.
+	C.duckdb_vector_assign_string_element_len(vec.duckdbVector, rowIdx, (*C.char)(unsafe.Pointer(unsafe.SliceData(b))), C.idx_t(len(b)))
+	return nil
+}
+
+func setBit[S any](vec *vector, rowIdx C.idx_t, val S) error {
+	var bits string
+	switch v := any(val).(type) {
+	case Bit:
+		bits = string(v)
+	case string:
+		bits = v
+	default:
+		return castError(reflect.TypeOf(val).String(), reflect.TypeOf(Bit("")).String())
+	}
+
+	blob, err := bitToBlob(bits)
+	if err != nil {
+		return err
+	}
+	return setBytes(vec, rowIdx, blob)
+}
+
+func setVarint[S any](vec *vector, rowIdx C.idx_t, val S) error {
+	var v *big.Int
+	switch x := any(val).(type) {
+	case *big.Int:
+		v = x
+	case big.Int:
+		v = &x
+	case int8:
+		v = big.NewInt(int64(x))
+	case int16:
+		v = big.NewInt(int64(x))
+	case int32:
+		v = big.NewInt(int64(x))
+	case int64:
+		v = big.NewInt(x)
+	case int:
+		v = big.NewInt(int64(x))
+	case uint8:
+		v = new(big.Int).SetUint64(uint64(x))
+	case uint16:
+		v = new(big.Int).SetUint64(uint64(x))
+	case uint32:
+		v = new(big.Int).SetUint64(uint64(x))
+	case uint64:
+		v = new(big.Int).SetUint64(x)
+	case uint:
+		v = new(big.Int).SetUint64(uint64(x))
+	default:
+		return castError(reflect.TypeOf(val).String(), reflect.TypeOf(v).String())
+	}
+	return setBytes(vec, rowIdx, varintToBlob(v))
+}
+
 func setJSON[S any](vec *vector, rowIdx C.idx_t, val S) error {
+	// Keep the formatting of raw JSON values.
+	if raw, ok := any(val).(json.RawMessage); ok {
+		if !json.Valid(raw) {
+			return invalidInputError(strconv.Quote(string(raw)), "a valid JSON value")
+		}
+		return setBytes(vec, rowIdx, []byte(raw))
+	}
+
 	bytes, err := json.Marshal(val)
 	if err != nil {
 		return err
@@ -268,6 +402,43 @@ func setJSON[S any](vec *vector, rowIdx C.idx_t, val S) error {
 }
 
 func setDecimal[S any](vec *vector, rowIdx C.idx_t, val S) error {
+	switch v := any(val).(type) {
+	case string:
+		r, ok := new(big.Rat).SetString(v)
+		if !ok {
+			return invalidInputError(strconv.Quote(v), "a decimal number")
+		}
+		return setDecimalRat(vec, rowIdx, r)
+	case *big.Rat:
+		if v == nil {
+			return castError(reflect.TypeOf(val).String(), reflect.TypeOf(Decimal{}).String())
+		}
+		return setDecimalRat(vec, rowIdx, v)
+	}
+	return setDecimalValue(vec, rowIdx, val)
+}
+
+// setDecimalRat scales r by the DECIMAL scale and sets the resulting value.
+// It returns an error, if r does not fit into the DECIMAL without rounding.
+func setDecimalRat(vec *vector, rowIdx C.idx_t, r *big.Rat) error {
+	factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(vec.decimalScale)), nil)
+	scaled := new(big.Rat).Mul(r, new(big.Rat).SetInt(factor))
+	expected := fmt.Sprintf("a value fitting into DECIMAL(%d,%d)", vec.decimalWidth, vec.decimalScale)
+	if !scaled.IsInt() {
+		return invalidInputError(r.RatString(), expected)
+	}
+
+	limit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(vec.decimalWidth)), nil)
+	value := scaled.Num()
+	if new(big.Int).Abs(value).Cmp(limit) >= 0 {
+		return invalidInputError(r.RatString(), expected)
+	}
+
+	d := Decimal{Width: vec.decimalWidth, Scale: vec.decimalScale, Value: value}
+	return setDecimalValue(vec, rowIdx, d)
+}
+
+func setDecimalValue[S any](vec *vector, rowIdx C.idx_t, val S) error {
 	switch vec.internalType {
 	case TYPE_SMALLINT:
 		return setNumeric[S, int16](vec, rowIdx, val)
@@ -282,27 +453,47 @@ func setDecimal[S any](vec *vector, rowIdx C.idx_t, val S) error {
 }
 
 func setEnum[S any](vec *vector, rowIdx C.idx_t, val S) error {
-	var str string
+	var idx uint64
 	switch v := any(val).(type) {
 	case string:
-		str = v
+		i, ok := vec.dict[v]
+		if !ok {
+			return invalidInputError(strconv.Quote(v), "an ENUM member")
+		}
+		idx = uint64(i)
 	default:
-		return castError(reflect.TypeOf(val).String(), reflect.TypeOf(str).String())
+		// Named string types are members, and integers are dictionary indexes.
+		rv := reflect.ValueOf(val)
+		switch {
+		case rv.Kind() == reflect.String:
+			i, ok := vec.dict[rv.String()]
+			if !ok {
+				return invalidInputError(strconv.Quote(rv.String()), "an ENUM member")
+			}
+			idx = uint64(i)
+		case rv.CanInt() && rv.Int() >= 0:
+			idx = uint64(rv.Int())
+		case rv.CanUint():
+			idx = rv.Uint()
+		case rv.CanInt():
+			return invalidInputError(strconv.FormatInt(rv.Int(), 10), "an ENUM index")
+		default:
+			return castError(reflect.TypeOf(val).String(), reflect.TypeOf("").String())
+		}
+		if idx >= uint64(len(vec.dict)) {
+			return invalidInputError(strconv.FormatUint(idx, 10), "an ENUM index below "+strconv.Itoa(len(vec.dict)))
+		}
 	}
 
-	if v, ok := vec.dict[str]; ok {
-		switch vec.internalType {
-		case TYPE_UTINYINT:
-			return setNumeric[uint32, int8](vec, rowIdx, v)
-		case TYPE_SMALLINT:
-			return setNumeric[uint32, int16](vec, rowIdx, v)
-		case TYPE_INTEGER:
-			return setNumeric[uint32, int32](vec, rowIdx, v)
-		case TYPE_BIGINT:
-			return setNumeric[uint32, int64](vec, rowIdx, v)
-		}
-	} else {
-		return castError(reflect.TypeOf(val).String(), reflect.TypeOf(str).String())
+	switch vec.internalType {
+	case TYPE_UTINYINT:
+		setPrimitive(vec, rowIdx, uint8(idx))
+	case TYPE_USMALLINT:
+		setPrimitive(vec, rowIdx, uint16(idx))
+	case TYPE_UINTEGER:
+		setPrimitive(vec, rowIdx, uint32(idx))
+	case TYPE_UBIGINT:
+		setPrimitive(vec, rowIdx, idx)
 	}
 	return nil
 }
@@ -332,30 +523,29 @@ func setStruct[S any](vec *vector, rowIdx C.idx_t, val S) error {
 	case map[string]any:
 		m = v
 	default:
-		// FIXME: Add support for all map types.
-
-		// Catch mismatching types.
-		goType := reflect.TypeOf(val)
-		if reflect.TypeOf(val).Kind() != reflect.Struct {
-			return castError(goType.String(), reflect.Struct.String())
-		}
-
-		m = make(map[string]any)
 		rv := reflect.ValueOf(val)
-		structType := rv.Type()
 
-		for i := 0; i < structType.NumField(); i++ {
-			if !rv.Field(i).CanInterface() {
-				continue
-			}
-			fieldName := structType.Field(i).Name
-			if name, ok := structType.Field(i).Tag.Lookup("db"); ok {
-				fieldName = name
-			}
-			if _, ok := m[fieldName]; ok {
-				return duplicateNameError(fieldName)
+		// A nil pointer is a NULL STRUCT.
+		if rv.Kind() == reflect.Pointer {
+			if rv.IsNil() {
+				vec.setNull(rowIdx)
+				return nil
 			}
-			m[fieldName] = rv.Field(i).Interface()
+			rv = rv.Elem()
+		}
+
+		var err error
+		switch rv.Kind() {
+		case reflect.Struct:
+			m, err = structToMap(rv)
+		case reflect.Map:
+			m, err = stringMapToMap(rv)
+		default:
+			// Catch mismatching types.
+			err = castError(reflect.TypeOf(val).String(), reflect.Struct.String())
+		}
+		if err != nil {
+			return err
 		}
 	}
 
@@ -371,16 +561,76 @@ func setStruct[S any](vec *vector, rowIdx C.idx_t, val S) error {
 			return err
 		}
 	}
+
+	// All STRUCT fields exist in m, so any additional entries are unknown fields.
+	if len(m) != len(vec.childVectors) {
+		for name := range m {
+			if !slices.ContainsFunc(vec.structEntries, func(entry StructEntry) bool { return entry.Name() == name }) {
+				return structFieldError("unknown field "+name, "no additional fields")
+			}
+		}
+	}
 	return nil
 }
 
+// structToMap maps the exported fields of a Go struct to their STRUCT field names.
+// The field name defaults to the Go field name, and a `db` tag overrides it.
+func structToMap(rv reflect.Value) (map[string]any, error) {
+	m := make(map[string]any)
+	structType := rv.Type()
+
+	for i := 0; i < structType.NumField(); i++ {
+		if !rv.Field(i).CanInterface() {
+			continue
+		}
+		fieldName := structType.Field(i).Name
+		if name, ok := structType.Field(i).Tag.Lookup("db"); ok {
+			fieldName = name
+		}
+		if _, ok := m[fieldName]; ok {
+			return nil, duplicateNameError(fieldName)
+		}
+		m[fieldName] = derefPrimitive(rv.Field(i)).Interface()
+	}
+	return m, nil
+}
+
+// stringMapToMap converts any Go map with string keys to a map[string]any.
+func stringMapToMap(rv reflect.Value) (map[string]any, error) {
+	if rv.Type().Key().Kind() != reflect.String {
+		return nil, castError(rv.Type().String(), reflect.TypeOf(map[string]any{}).String())
+	}
+
+	m := make(map[string]any, rv.Len())
+	iter := rv.MapRange()
+	for iter.Next() {
+		m[iter.Key().String()] = derefPrimitive(iter.Value()).Interface()
+	}
+	return m, nil
+}
+
 func setMap[S any](vec *vector, rowIdx C.idx_t, val S) error {
 	var m Map
 	switch v := any(val).(type) {
 	case Map:
 		m = v
 	default:
-		return castError(reflect.TypeOf(val).String(), reflect.TypeOf(m).String())
+		// Convert any Go map to a Map.
+		rv := reflect.ValueOf(val)
+		if rv.Kind() != reflect.Map {
+			return castError(reflect.TypeOf(val).String(), reflect.TypeOf(m).String())
+		}
+
+		m = make(Map, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			value := iter.Value()
+			if vec.canNil(value) && value.IsNil() {
+				m[iter.Key().Interface()] = nil
+				continue
+			}
+			m[iter.Key().Interface()] = value.Interface()
+		}
 	}
 
 	// Create a LIST of STRUCT values.
@@ -400,7 +650,7 @@ func setArray[S any](vec *vector, rowIdx C.idx_t, val S) error {
 		return err
 	}
 	if len(array) != int(vec.arrayLength) {
-		return invalidInputError(strconv.Itoa(len(array)), strconv.Itoa(int(vec.arrayLength)))
+		return invalidInputError(strconv.Itoa(len(array))+" elements", strconv.Itoa(int(vec.arrayLength))+" ARRAY elements")
 	}
 	return setSliceChildren(vec, array, rowIdx*C.idx_t(vec.arrayLength))
 }
@@ -426,12 +676,33 @@ func extractSlice[S any](vec *vector, val S) ([]any, error) {
 				continue
 			}
 
-			s[i] = idx.Interface()
+			s[i] = derefPrimitive(idx).Interface()
 		}
 	}
 	return s, nil
 }
 
+// derefPrimitive dereferences a non-nil pointer to a primitive value, e.g., the elements of a []*string or
+// the fields of a struct, as the setters expect these values instead of pointers.
+// Other pointers, e.g., a *big.Rat, remain unchanged.
+func derefPrimitive(rv reflect.Value) reflect.Value {
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return rv
+	}
+	elem := rv.Elem()
+	switch elem.Kind() {
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64, reflect.String:
+		return elem
+	}
+	switch elem.Type() {
+	case reflectTypeTime, reflectTypeInterval, reflectTypeBytes, reflect.TypeOf(Decimal{}):
+		return elem
+	}
+	return rv
+}
+
 func setSliceChildren(vec *vector, s []any, offset C.idx_t) error {
 	childVector := &vec.childVectors[0]
 
@@ -459,14 +730,54 @@ func setUUID[S any](vec *vector, rowIdx C.idx_t, val S) error {
 		for i := 0; i < uuid_length; i++ {
 			uuid[i] = v[i]
 		}
+	case string:
+		if err := uuid.parse(v); err != nil {
+			return err
+		}
 	default:
-		return castError(reflect.TypeOf(val).String(), reflect.TypeOf(uuid).String())
+		// Accept other UUID types with the same underlying type, e.g., uuid.UUID of github.com/google/uuid.
+		rv := reflect.ValueOf(val)
+		if !rv.Type().ConvertibleTo(reflect.TypeOf(uuid)) || rv.Kind() != reflect.Array {
+			return castError(reflect.TypeOf(val).String(), reflect.TypeOf(uuid).String())
+		}
+		uuid = rv.Convert(reflect.TypeOf(uuid)).Interface().(UUID)
 	}
 	hi := uuidToHugeInt(uuid)
 	setPrimitive(vec, rowIdx, hi)
 	return nil
 }
 
+func setUnion[S any](vec *vector, rowIdx C.idx_t, val S) error {
+	var u Union
+	switch v := any(val).(type) {
+	case Union:
+		u = v
+	case *Union:
+		u = *v
+	default:
+		return castError(reflect.TypeOf(val).String(), reflect.TypeOf(u).String())
+	}
+
+	tag, ok := vec.dict[u.Tag]
+	if !ok {
+		return invalidInputError(u.Tag, "UNION member name")
+	}
+	setPrimitive(&vec.childVectors[0], rowIdx, uint8(tag))
+
+	// Only the selected member is valid, all other members are NULL.
+	for i := 1; i < len(vec.childVectors); i++ {
+		member := &vec.childVectors[i]
+		if uint32(i-1) != tag {
+			member.setNull(rowIdx)
+			continue
+		}
+		if err := member.setFn(member, rowIdx, u.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func setVectorVal[S any](vec *vector, rowIdx C.idx_t, val S) error {
 	name, inMap := unsupportedTypeToStringMap[vec.Type]
 	if inMap {
@@ -506,6 +817,8 @@ func setVectorVal[S any](vec *vector, rowIdx C.idx_t, val S) error {
 		return setInterval[S](vec, rowIdx, val)
 	case TYPE_HUGEINT:
 		return setHugeint[S](vec, rowIdx, val)
+	case TYPE_UHUGEINT:
+		return setUhugeint[S](vec, rowIdx, val)
 	case TYPE_VARCHAR:
 		return setBytes[S](vec, rowIdx, val)
 	case TYPE_BLOB:
@@ -518,12 +831,70 @@ func setVectorVal[S any](vec *vector, rowIdx C.idx_t, val S) error {
 		return setList[S](vec, rowIdx, val)
 	case TYPE_STRUCT:
 		return setStruct[S](vec, rowIdx, val)
-	case TYPE_MAP, TYPE_ARRAY:
-		// FIXME: Is this already supported? And tested?
-		return unsupportedTypeError(unsupportedTypeToStringMap[vec.Type])
+	case TYPE_MAP:
+		return setMap[S](vec, rowIdx, val)
+	case TYPE_ARRAY:
+		return setArray[S](vec, rowIdx, val)
+	case TYPE_UNION:
+		return setUnion[S](vec, rowIdx, val)
+	case TYPE_BIT:
+		return setBit[S](vec, rowIdx, val)
+	case TYPE_VARINT:
+		return setVarint[S](vec, rowIdx, val)
 	case TYPE_UUID:
 		return setUUID[S](vec, rowIdx, val)
 	default:
 		return unsupportedTypeError(unknownTypeErrMsg)
 	}
 }
+
+// setColumn writes the values of a column slice created by newColumn to the vector.
+// A nil element results in NULL.
+func (vec *vector) setColumn(column any) error {
+	switch s := column.(type) {
+	case []bool:
+		setPrimitiveColumn(vec, s)
+	case []int8:
+		setPrimitiveColumn(vec, s)
+	case []int16:
+		setPrimitiveColumn(vec, s)
+	case []int32:
+		setPrimitiveColumn(vec, s)
+	case []int64:
+		setPrimitiveColumn(vec, s)
+	case []uint8:
+		setPrimitiveColumn(vec, s)
+	case []uint16:
+		setPrimitiveColumn(vec, s)
+	case []uint32:
+		setPrimitiveColumn(vec, s)
+	case []uint64:
+		setPrimitiveColumn(vec, s)
+	case []float32:
+		setPrimitiveColumn(vec, s)
+	case []float64:
+		setPrimitiveColumn(vec, s)
+	default:
+		rv := reflect.ValueOf(column)
+		for i := 0; i < rv.Len(); i++ {
+			elem := rv.Index(i)
+			var val any
+			if !vec.canNil(elem) || !elem.IsNil() {
+				val = elem.Interface()
+			}
+			if err := vec.setFn(vec, C.idx_t(i), val); err != nil {
+				return addIndexToError(err, i)
+			}
+		}
+	}
+	return nil
+}
+
+func setPrimitiveColumn[T any](vec *vector, column []T) {
+	copy(unsafe.Slice((*T)(vec.ptr), len(column)), column)
+}
+
+// newColumn returns a slice for size values of the vector's type.
+func (vec *vector) newColumn(size int) any {
+	return reflect.MakeSlice(reflect.SliceOf(vec.columnElemType()), size, size).Interface()
+}